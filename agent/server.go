@@ -19,9 +19,11 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/etcd-io/dbtester"
 	"github.com/etcd-io/dbtester/dbtesterpb"
 	"github.com/etcd-io/dbtester/pkg/fileinspect"
 
@@ -61,6 +63,12 @@ type transporterServer struct {
 
 	// notified after all tests finish
 	notifier chan os.Signal
+
+	// loadGen runs the part of the benchmark requested
+	// by the control machine, when used as load generator,
+	// guarded by 'loadGenMu' against concurrent requests
+	loadGenMu sync.Mutex
+	loadGen   *dbtester.LoadGenerator
 }
 
 // NewServer returns a new server that implements gRPC interface.
@@ -154,6 +162,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 	}
 
 	var diskSpaceUsageBytes int64
+	var stressStats []byte
 	switch req.Operation {
 	case dbtesterpb.Operation_Start:
 		switch t.req.DatabaseID {
@@ -292,12 +301,90 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			return nil, err
 		}
 
+	case dbtesterpb.Operation_StressPrepare:
+		t.loadGenMu.Lock()
+		if t.loadGen != nil {
+			select {
+			case <-t.loadGen.Done():
+				// finished without "StressResult", e.g. not started in time
+				t.lg.Warn("dropping finished load generator")
+			default:
+				t.loadGenMu.Unlock()
+				return nil, fmt.Errorf("load generator is already prepared")
+			}
+		}
+		lg, err := dbtester.NewLoadGenerator(t.lg, req)
+		if err != nil {
+			t.loadGenMu.Unlock()
+			return nil, err
+		}
+		t.loadGen = lg
+		t.loadGenMu.Unlock()
+
+		t.lg.Info("preparing load generator", zap.Uint32("index", req.LoadGeneratorIndex))
+		if err = lg.WaitReady(ctx); err != nil {
+			// finished with the error, or the control machine is gone
+			lg.Cancel()
+			t.clearLoadGen(lg)
+			return nil, err
+		}
+		t.lg.Info("load generator is ready", zap.Uint32("index", req.LoadGeneratorIndex))
+
+	case dbtesterpb.Operation_StressStart:
+		lg := t.getLoadGen()
+		if lg == nil {
+			return nil, fmt.Errorf("load generator is not prepared")
+		}
+		at := time.Unix(0, req.StartUnixNano)
+		t.lg.Info("starting load generator", zap.Time("start", at))
+		lg.Start(at)
+
+	case dbtesterpb.Operation_StressResult:
+		lg := t.getLoadGen()
+		if lg == nil {
+			return nil, fmt.Errorf("load generator is not prepared")
+		}
+		stats, err := lg.Wait(ctx)
+		if ctx.Err() != nil {
+			// the control machine is gone, so no one waits for the stats
+			lg.Cancel()
+		}
+		t.clearLoadGen(lg)
+		if err != nil {
+			return nil, err
+		}
+		stressStats = stats
+		t.lg.Info("load generator is finished", zap.Int("stats-bytes", len(stats)))
+
+	case dbtesterpb.Operation_StressCancel:
+		// "StressResult" returns the stats so far, and clears it
+		if lg := t.getLoadGen(); lg != nil {
+			t.lg.Info("canceling load generator")
+			lg.Cancel()
+		}
+
 	default:
 		return nil, fmt.Errorf("Not implemented %v", req.Operation)
 	}
 
 	t.lg.Info("Transfer success!")
-	return &dbtesterpb.Response{Success: true, DiskSpaceUsageBytes: diskSpaceUsageBytes}, nil
+	return &dbtesterpb.Response{Success: true, DiskSpaceUsageBytes: diskSpaceUsageBytes, StressStats: stressStats}, nil
+}
+
+func (t *transporterServer) getLoadGen() *dbtester.LoadGenerator {
+	t.loadGenMu.Lock()
+	defer t.loadGenMu.Unlock()
+	return t.loadGen
+}
+
+// clearLoadGen clears the finished load generator, so that the next
+// one can be prepared, unless already cleared by another request.
+func (t *transporterServer) clearLoadGen(lg *dbtester.LoadGenerator) {
+	t.loadGenMu.Lock()
+	defer t.loadGenMu.Unlock()
+	if t.loadGen == lg {
+		t.loadGen = nil
+	}
 }
 
 func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (int64, error) {
@@ -498,7 +498,11 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 		cdata = append(cdata, point)
 	}
 
-	// aggregate memory,write/read bytes by number of keys
+	// aggregate memory,write/read bytes by number of keys,
+	// up to the cumulative throughput if the total is unknown
+	if totalRequests == 0 {
+		totalRequests = int64(requestSum)
+	}
 	knms := dbtester.FindRangesData(cdata, 1000, totalRequests)
 
 	ckk1 := dataframe.NewColumn("KEYS")
@@ -90,7 +90,13 @@ func do(configPath string) error {
 		if err = ad.importBenchMetrics(testdata.ClientLatencyThroughputTimeseriesPath); err != nil {
 			return err
 		}
-		if err = ad.aggregateAll(testdata.ServerMemoryByKeyNumberPath, testdata.ServerReadBytesDeltaByKeyNumberPath, testdata.ServerWriteBytesDeltaByKeyNumberPath, testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber); err != nil {
+		// runs with deadline complete unknown number of requests,
+		// 0 to aggregate as many as the benchmark throughput adds up to
+		totalRequests := testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber
+		if testgroup.ConfigClientMachineBenchmarkOptions.DurationSeconds > 0 {
+			totalRequests = 0
+		}
+		if err = ad.aggregateAll(testdata.ServerMemoryByKeyNumberPath, testdata.ServerReadBytesDeltaByKeyNumberPath, testdata.ServerWriteBytesDeltaByKeyNumberPath, totalRequests); err != nil {
 			return err
 		}
 		if err = ad.save(); err != nil {
@@ -281,7 +287,10 @@ func do(configPath string) error {
 		if tag != row00Header[i+1] {
 			return fmt.Errorf("analyze config has different order; expected %q, got %q", row00Header[i+1], tag)
 		}
-		row02TotalRequestNumber = append(row02TotalRequestNumber, humanize.Comma(testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber))
+		// runs with deadline read the completed requests from the summary
+		if testgroup.ConfigClientMachineBenchmarkOptions.DurationSeconds == 0 {
+			row02TotalRequestNumber = append(row02TotalRequestNumber, humanize.Comma(testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber))
+		}
 
 		{
 			fr, err := dataframe.NewFromCSV(nil, testdata.ClientSystemMetricsInterpolatedPath)
@@ -383,6 +392,14 @@ func do(configPath string) error {
 				switch row[0] {
 				case "TOTAL-SECONDS":
 					row01TotalSeconds = append(row01TotalSeconds, fmt.Sprintf("%s sec", row[1]))
+				case "TOTAL-REQUESTS":
+					if testgroup.ConfigClientMachineBenchmarkOptions.DurationSeconds > 0 {
+						n, err := strconv.ParseInt(row[1], 10, 64)
+						if err != nil {
+							return err
+						}
+						row02TotalRequestNumber = append(row02TotalRequestNumber, humanize.Comma(n))
+					}
 				case "REQUESTS-PER-SECOND":
 					fv, err := strconv.ParseFloat(row[1], 64)
 					if err != nil {
@@ -0,0 +1,99 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/etcd-io/dbtester/pkg/linearizability"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// linearizabilityCommand implements 'analyze linearizability' command.
+var linearizabilityCommand = &cobra.Command{
+	Use:   "linearizability",
+	Short: "Checks the operation history of 'register' test for linearizability.",
+	RunE:  linearizabilityCommandFunc,
+}
+
+var (
+	historyPath            string
+	linearizabilityTimeout time.Duration
+)
+
+func init() {
+	linearizabilityCommand.Flags().StringVar(&historyPath, "history", "", "Operation history file path (e.g. 'etcd-client-latency-distribution-summary-history.jsonl').")
+	linearizabilityCommand.Flags().DurationVar(&linearizabilityTimeout, "timeout", 10*time.Minute, "Timeout to check each key, 0 for no timeout.")
+	Command.AddCommand(linearizabilityCommand)
+}
+
+func linearizabilityCommandFunc(cmd *cobra.Command, args []string) error {
+	if historyPath == "" {
+		return fmt.Errorf("empty history path")
+	}
+	ops, err := linearizability.ReadHistoryFile(historyPath)
+	if err != nil {
+		return err
+	}
+	lg.Sugar().Infof("checking %d operations in %q", len(ops), historyPath)
+	return checkLinearizability(os.Stdout, ops, linearizabilityTimeout)
+}
+
+// checkLinearizability writes the result of each key and the
+// counterexamples, and returns an error if any key is not linearizable
+// or timed out.
+func checkLinearizability(w io.Writer, ops []linearizability.Operation, timeout time.Duration) error {
+	rs := linearizability.Check(ops, timeout)
+
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"KEY", "OPERATIONS", "RESULT"})
+	violations, timeouts := 0, 0
+	for _, r := range rs {
+		result := "LINEARIZABLE"
+		switch {
+		case r.TimedOut:
+			result = "TIMED-OUT"
+			timeouts++
+		case !r.Linearizable:
+			result = "VIOLATION"
+			violations++
+		}
+		tw.Append([]string{r.Key, fmt.Sprintf("%d", r.Operations), result})
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	for _, r := range rs {
+		if len(r.Counterexample) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\nkey %q is not linearizable, with minimal history of %d operations:\n", r.Key, len(r.Counterexample))
+		for _, op := range r.Counterexample {
+			fmt.Fprintf(w, "  %s\n", op)
+		}
+	}
+
+	if violations > 0 || timeouts > 0 {
+		return fmt.Errorf("%d of %d keys not linearizable, %d timed out", violations, len(rs), timeouts)
+	}
+	fmt.Fprintf(w, "\nall %d keys are linearizable\n", len(rs))
+	return nil
+}
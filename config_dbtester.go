@@ -17,6 +17,7 @@ package dbtester
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -39,6 +40,20 @@ func MakeTag(desc string) string {
 // Config configures dbtester control clients.
 type Config struct {
 	lg *zap.Logger
+	// part is the part of a distributed benchmark,
+	// only set on the load generator machines.
+	part *loadPart
+	// liveMetrics are served to Prometheus, nil if not enabled.
+	liveMetrics *liveMetrics
+	// written records the puts of "write" workload to verify after
+	// the run, nil if not enabled.
+	written *writeRecorder
+	// trace records the requests sent by the workload,
+	// nil if not enabled.
+	trace *traceRecorder
+	// history records the operations of "register" workload,
+	// nil for the other workloads.
+	history *historyRecorder
 
 	TestTitle       string `yaml:"test_title"`
 	TestDescription string `yaml:"test_description"`
@@ -133,6 +148,7 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
 	}
 
+	const defaultRangePrefix = "range-"
 	for databaseID, ctrl := range cfg.DatabaseIDToConfigClientMachineAgentControl {
 		if databaseID != dbtesterpb.DatabaseID_etcd__other.String() &&
 			databaseID != dbtesterpb.DatabaseID_etcd__tip.String() &&
@@ -141,6 +157,261 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 			ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber != ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber {
 			return nil, fmt.Errorf("%q got connected %d != clients %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber)
 		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber < 0 || ctrl.ConfigClientMachineBenchmarkOptions.DurationSeconds < 0 {
+			return nil, fmt.Errorf("%q got invalid requests %d or duration %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber, ctrl.ConfigClientMachineBenchmarkOptions.DurationSeconds)
+		}
+		// "replay" sends all requests of the trace by default
+		if ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber == 0 && ctrl.ConfigClientMachineBenchmarkOptions.DurationSeconds == 0 && ctrl.ConfigClientMachineBenchmarkOptions.SLOSearch == nil && ctrl.ConfigClientMachineBenchmarkOptions.Type != "replay" {
+			return nil, fmt.Errorf("%q got neither requests nor duration", databaseID)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.OpenLoop && ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond <= 0 && len(ctrl.ConfigClientMachineBenchmarkOptions.LoadProfile) == 0 {
+			return nil, fmt.Errorf("%q got open loop without request rate", databaseID)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.WarmupSeconds < 0 || ctrl.ConfigClientMachineBenchmarkOptions.WarmupRequests < 0 || ctrl.ConfigClientMachineBenchmarkOptions.CooldownSeconds < 0 || ctrl.ConfigClientMachineBenchmarkOptions.CooldownRequests < 0 {
+			return nil, fmt.Errorf("%q got invalid warm-up (%d seconds, %d requests) or cool-down (%d seconds, %d requests)", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.WarmupSeconds, ctrl.ConfigClientMachineBenchmarkOptions.WarmupRequests, ctrl.ConfigClientMachineBenchmarkOptions.CooldownSeconds, ctrl.ConfigClientMachineBenchmarkOptions.CooldownRequests)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.RequestTimeoutMilliseconds < 0 {
+			return nil, fmt.Errorf("%q got invalid request timeout %d ms", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RequestTimeoutMilliseconds)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.RetryMaxAttempts < 0 || ctrl.ConfigClientMachineBenchmarkOptions.RetryBackoffMilliseconds < 0 || ctrl.ConfigClientMachineBenchmarkOptions.RetryMaxBackoffMilliseconds < 0 {
+			return nil, fmt.Errorf("%q got invalid retry max attempts %d, backoff %d ms or max backoff %d ms", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RetryMaxAttempts, ctrl.ConfigClientMachineBenchmarkOptions.RetryBackoffMilliseconds, ctrl.ConfigClientMachineBenchmarkOptions.RetryMaxBackoffMilliseconds)
+		}
+		if err = validateRetryErrorCategories(ctrl.ConfigClientMachineBenchmarkOptions.RetryErrorCategories); err != nil {
+			return nil, fmt.Errorf("%q got invalid retry error categories (%v)", databaseID, err)
+		}
+		if len(ctrl.ConfigClientMachineBenchmarkOptions.LoadProfile) > 0 {
+			if ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+				return nil, fmt.Errorf("%q got both request rate %d and load profile", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond)
+			}
+			// each range of client numbers would restart the profile
+			if len(ctrl.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) > 0 {
+				return nil, fmt.Errorf("%q got both connection client numbers and load profile", databaseID)
+			}
+			if err = validateLoadProfile(ctrl.ConfigClientMachineBenchmarkOptions.LoadProfile); err != nil {
+				return nil, fmt.Errorf("%q got invalid load profile (%v)", databaseID, err)
+			}
+		}
+		if s := ctrl.ConfigClientMachineBenchmarkOptions.SLOSearch; s != nil {
+			if ctrl.ConfigClientMachineBenchmarkOptions.Type != "write" && ctrl.ConfigClientMachineBenchmarkOptions.Type != "read" {
+				return nil, fmt.Errorf("%q got SLO search for %q workload", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.Type)
+			}
+			// the search sets the rate and the duration of each probe
+			if ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 || len(ctrl.ConfigClientMachineBenchmarkOptions.LoadProfile) > 0 || len(ctrl.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) > 0 || len(ctrl.LoadGeneratorEndpoints) > 0 {
+				return nil, fmt.Errorf("%q got SLO search with request rate, load profile, connection client numbers or load generators", databaseID)
+			}
+			if s.LatencyPercentile <= 0 || s.LatencyPercentile > 100 || s.MaxLatencyMilliseconds <= 0 {
+				return nil, fmt.Errorf("%q got invalid SLO latency p%g < %g ms", databaseID, s.LatencyPercentile, s.MaxLatencyMilliseconds)
+			}
+			if s.MaxErrorPercent < 0 || s.MaxErrorPercent > 100 || s.MinThroughputPercent < 0 || s.MinThroughputPercent > 100 {
+				return nil, fmt.Errorf("%q got invalid SLO max error percent %g or min throughput percent %g", databaseID, s.MaxErrorPercent, s.MinThroughputPercent)
+			}
+			if s.ProbeSeconds <= ctrl.ConfigClientMachineBenchmarkOptions.WarmupSeconds+ctrl.ConfigClientMachineBenchmarkOptions.CooldownSeconds {
+				return nil, fmt.Errorf("%q got SLO probe seconds %d <= warm-up and cool-down seconds %d", databaseID, s.ProbeSeconds, ctrl.ConfigClientMachineBenchmarkOptions.WarmupSeconds+ctrl.ConfigClientMachineBenchmarkOptions.CooldownSeconds)
+			}
+			if s.MinRequestsPerSecond <= 0 || s.MaxRequestsPerSecond < s.MinRequestsPerSecond || s.ResolutionRequestsPerSecond < 0 {
+				return nil, fmt.Errorf("%q got invalid SLO search rates [%d, %d] with resolution %d", databaseID, s.MinRequestsPerSecond, s.MaxRequestsPerSecond, s.ResolutionRequestsPerSecond)
+			}
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.VerifyWrites {
+			if ctrl.ConfigClientMachineBenchmarkOptions.Type != "write" {
+				return nil, fmt.Errorf("%q got write verification for %q workload", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.Type)
+			}
+			// the writes are recorded on each load generator
+			if ctrl.ConfigClientMachineBenchmarkOptions.SLOSearch != nil || len(ctrl.LoadGeneratorEndpoints) > 0 {
+				return nil, fmt.Errorf("%q got write verification with SLO search or load generators", databaseID)
+			}
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.VerifySampleSize < 0 {
+			return nil, fmt.Errorf("%q got invalid verify sample size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.VerifySampleSize)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.RecordTrace {
+			// "lease" sends the requests of its own
+			if ctrl.ConfigClientMachineBenchmarkOptions.Type == "lease" {
+				return nil, fmt.Errorf("%q got trace recording for %q workload", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.Type)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.SLOSearch != nil || len(ctrl.LoadGeneratorEndpoints) > 0 {
+				return nil, fmt.Errorf("%q got trace recording with SLO search or load generators", databaseID)
+			}
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber > 0 && ctrl.ConfigClientMachineBenchmarkOptions.WarmupRequests+ctrl.ConfigClientMachineBenchmarkOptions.CooldownRequests >= ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber {
+			return nil, fmt.Errorf("%q got warm-up and cool-down requests %d >= requests %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.WarmupRequests+ctrl.ConfigClientMachineBenchmarkOptions.CooldownRequests, ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.DurationSeconds > 0 && ctrl.ConfigClientMachineBenchmarkOptions.WarmupSeconds+ctrl.ConfigClientMachineBenchmarkOptions.CooldownSeconds >= ctrl.ConfigClientMachineBenchmarkOptions.DurationSeconds {
+			return nil, fmt.Errorf("%q got warm-up and cool-down seconds %d >= duration %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.WarmupSeconds+ctrl.ConfigClientMachineBenchmarkOptions.CooldownSeconds, ctrl.ConfigClientMachineBenchmarkOptions.DurationSeconds)
+		}
+		switch ctrl.ConfigClientMachineBenchmarkOptions.Type {
+		case "delete":
+			// deletes the preloaded 'RequestNumber' keys
+			if ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber == 0 {
+				return nil, fmt.Errorf("%q got no request number for delete", databaseID)
+			}
+		case "mixed":
+			if ctrl.ConfigClientMachineBenchmarkOptions.ReadPercent < 0 || ctrl.ConfigClientMachineBenchmarkOptions.ReadPercent > 100 {
+				return nil, fmt.Errorf("%q got invalid read percent %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ReadPercent)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize <= 0 {
+				return nil, fmt.Errorf("%q got invalid key space size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize)
+			}
+		case "register":
+			if ctrl.ConfigClientMachineBenchmarkOptions.ReadPercent < 0 || ctrl.ConfigClientMachineBenchmarkOptions.ReadPercent > 100 {
+				return nil, fmt.Errorf("%q got invalid read percent %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ReadPercent)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize <= 0 {
+				return nil, fmt.Errorf("%q got invalid key space size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize)
+			}
+			// the retried put may take effect twice, out of the history
+			if ctrl.ConfigClientMachineBenchmarkOptions.RetryMaxAttempts > 1 {
+				return nil, fmt.Errorf("%q got retry max attempts %d, not supported for %q", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RetryMaxAttempts, ctrl.ConfigClientMachineBenchmarkOptions.Type)
+			}
+		case "replay":
+			if ctrl.ConfigClientMachineBenchmarkOptions.ReplayTracePath == "" {
+				return nil, fmt.Errorf("%q got empty replay trace path", databaseID)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.ReplaySpeedup < 0 {
+				return nil, fmt.Errorf("%q got invalid replay speedup %g", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ReplaySpeedup)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize < 0 {
+				return nil, fmt.Errorf("%q got invalid key space size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize)
+			}
+			// the trace sets the time of each request
+			if ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 || len(ctrl.ConfigClientMachineBenchmarkOptions.LoadProfile) > 0 || ctrl.ConfigClientMachineBenchmarkOptions.OpenLoop {
+				return nil, fmt.Errorf("%q got replay with request rate, load profile or open loop", databaseID)
+			}
+		case "range":
+			if ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize <= 0 {
+				return nil, fmt.Errorf("%q got invalid key space size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.RangeLimit < 0 {
+				return nil, fmt.Errorf("%q got invalid range limit %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RangeLimit)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.RangePrefix == "" {
+				ctrl.ConfigClientMachineBenchmarkOptions.RangePrefix = defaultRangePrefix
+			}
+		case "txn":
+			if ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize <= 0 {
+				return nil, fmt.Errorf("%q got invalid key space size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.TxnMode == "" {
+				ctrl.ConfigClientMachineBenchmarkOptions.TxnMode = txnModeCAS
+			}
+			switch ctrl.ConfigClientMachineBenchmarkOptions.TxnMode {
+			case txnModeCAS, txnModeMulti:
+			default:
+				return nil, fmt.Errorf("%q got unknown txn mode %q", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.TxnMode)
+			}
+		case "watch":
+			if len(ctrl.ConfigClientMachineBenchmarkOptions.WatcherNumbers) == 0 && ctrl.ConfigClientMachineBenchmarkOptions.WatcherNumber <= 0 {
+				return nil, fmt.Errorf("%q got invalid watcher number %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.WatcherNumber)
+			}
+			for _, v := range ctrl.ConfigClientMachineBenchmarkOptions.WatcherNumbers {
+				if v <= 0 {
+					return nil, fmt.Errorf("%q got invalid watcher number %d", databaseID, v)
+				}
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.WatchMode == "" {
+				ctrl.ConfigClientMachineBenchmarkOptions.WatchMode = watchModeKey
+			}
+			switch ctrl.ConfigClientMachineBenchmarkOptions.WatchMode {
+			case watchModeKey, watchModeChildren:
+			default:
+				return nil, fmt.Errorf("%q got unknown watch mode %q", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.WatchMode)
+			}
+		case "lease":
+			if ctrl.ConfigClientMachineBenchmarkOptions.LeaseTTLSeconds <= 0 {
+				return nil, fmt.Errorf("%q got invalid lease TTL %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.LeaseTTLSeconds)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.LeaseKeepAliveSeconds < 0 {
+				return nil, fmt.Errorf("%q got invalid lease keep-alive duration %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.LeaseKeepAliveSeconds)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber < ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber {
+				return nil, fmt.Errorf("%q got requests %d < clients %d, with no key bound to some leases", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber)
+			}
+		}
+
+		if dist := ctrl.ConfigClientMachineBenchmarkOptions.KeyDistribution; dist != "" {
+			if !isValidKeyDistribution(dist) {
+				return nil, fmt.Errorf("%q got unknown key distribution %q", databaseID, dist)
+			}
+			// "write" creates new keys with "sequential" and "latest"
+			if (ctrl.ConfigClientMachineBenchmarkOptions.Type != "write" || !insertsKeys(dist)) && ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize <= 0 {
+				return nil, fmt.Errorf("%q got invalid key space size %d for %q distribution", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeySpaceSize, dist)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.ZipfianSkew == 0 {
+				ctrl.ConfigClientMachineBenchmarkOptions.ZipfianSkew = defaultZipfianSkew
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.ZipfianSkew <= 0 || ctrl.ConfigClientMachineBenchmarkOptions.ZipfianSkew >= 1 {
+				return nil, fmt.Errorf("%q got invalid zipfian skew %f", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ZipfianSkew)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.HotspotKeyPercent == 0 {
+				ctrl.ConfigClientMachineBenchmarkOptions.HotspotKeyPercent = defaultHotspotKeyPercent
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.HotspotKeyPercent < 0 || ctrl.ConfigClientMachineBenchmarkOptions.HotspotKeyPercent > 100 {
+				return nil, fmt.Errorf("%q got invalid hotspot key percent %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.HotspotKeyPercent)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.HotspotRequestPercent == 0 {
+				ctrl.ConfigClientMachineBenchmarkOptions.HotspotRequestPercent = defaultHotspotRequestPercent
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.HotspotRequestPercent < 0 || ctrl.ConfigClientMachineBenchmarkOptions.HotspotRequestPercent > 100 {
+				return nil, fmt.Errorf("%q got invalid hotspot request percent %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.HotspotRequestPercent)
+			}
+		}
+
+		switch dist := ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeDistribution; dist {
+		case "", valueSizeFixed:
+		case valueSizeUniform:
+			if ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMinBytes < 0 || ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMaxBytes < ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMinBytes {
+				return nil, fmt.Errorf("%q got invalid value size range [%d, %d]", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMinBytes, ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMaxBytes)
+			}
+		case valueSizeNormal:
+			if ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeStddevBytes < 0 {
+				return nil, fmt.Errorf("%q got invalid value size stddev %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeStddevBytes)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMinBytes < 0 {
+				return nil, fmt.Errorf("%q got invalid min value size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeMinBytes)
+			}
+		case valueSizeHistogram:
+			if ctrl.ConfigClientMachineBenchmarkOptions.ValueSizeHistogramPath == "" {
+				return nil, fmt.Errorf("%q got empty value size histogram path", databaseID)
+			}
+		default:
+			return nil, fmt.Errorf("%q got unknown value size distribution %q", databaseID, dist)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.ValuePoolSize < 0 {
+			return nil, fmt.Errorf("%q got invalid value pool size %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ValuePoolSize)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.ValueCompressibility < 0 || ctrl.ConfigClientMachineBenchmarkOptions.ValueCompressibility >= 1 {
+			return nil, fmt.Errorf("%q got invalid value compressibility %f", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ValueCompressibility)
+		}
+
+		// each load generator needs at least one client and request
+		if n := int64(len(ctrl.LoadGeneratorEndpoints)); n > 0 {
+			switch ctrl.ConfigClientMachineBenchmarkOptions.Type {
+			case "watch", "lease", "delete", "register", "replay":
+				return nil, fmt.Errorf("%q got %d load generators, not supported for %q", databaseID, n, ctrl.ConfigClientMachineBenchmarkOptions.Type)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber < n || ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber < n {
+				return nil, fmt.Errorf("%q got clients %d or connections %d < load generators %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber, ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber, n)
+			}
+			for _, v := range ctrl.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers {
+				if v < n {
+					return nil, fmt.Errorf("%q got connection client number %d < load generators %d", databaseID, v, n)
+				}
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber > 0 && ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber < n {
+				return nil, fmt.Errorf("%q got requests %d < load generators %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber, n)
+			}
+			if ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 && ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond < n {
+				return nil, fmt.Errorf("%q got request rate %d < load generators %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, n)
+			}
+			// new sequential keys are split by the request number
+			if ctrl.ConfigClientMachineBenchmarkOptions.Type == "write" && !ctrl.ConfigClientMachineBenchmarkOptions.SameKey && insertsKeys(ctrl.ConfigClientMachineBenchmarkOptions.KeyDistribution) && ctrl.ConfigClientMachineBenchmarkOptions.RequestNumber == 0 {
+				return nil, fmt.Errorf("%q got no request number to split new keys across %d load generators", databaseID, n)
+			}
+			// each load generator would insert the same keys after the preloaded ones
+			if ctrl.ConfigClientMachineBenchmarkOptions.Type == "mixed" && ctrl.ConfigClientMachineBenchmarkOptions.KeyDistribution == keyDistributionLatest {
+				return nil, fmt.Errorf("%q got %d load generators, not supported for %q with %q key distribution", databaseID, n, ctrl.ConfigClientMachineBenchmarkOptions.Type, keyDistributionLatest)
+			}
+		}
 	}
 
 	const (
@@ -148,6 +419,7 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		defaultEtcdClientPort      int64 = 2379
 		defaultZookeeperClientPort int64 = 2181
 		defaultConsulClientPort    int64 = 8500
+		defaultYTsaurusRPCPort     int64 = 9013
 
 		defaultEtcdSnapshotCount             int64 = 100000
 		defaultEtcdQuotaSizeBytes            int64 = 8000000000
@@ -156,6 +428,10 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		defaultZookeeperInitLimit            int64 = 5
 		defaultZookeeperSyncLimit            int64 = 5
 		defaultZookeeperMaxClientConnections int64 = 5000
+
+		defaultYTsaurusTokenEnv    = "YT_TOKEN"
+		defaultYTsaurusRootPath    = "//tmp/dbtester"
+		defaultYTsaurusStorageMode = ytsaurusStorageAttribute
 	)
 
 	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()]; ok {
@@ -258,6 +534,41 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_consul__v1_0_2.String()] = v
 	}
 
+	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_ytsaurus_cypress.String()]; ok {
+		if v.AgentPortToConnect == 0 {
+			v.AgentPortToConnect = defaultAgentPort
+		}
+		if v.DatabasePortToConnect == 0 {
+			v.DatabasePortToConnect = defaultYTsaurusRPCPort
+		}
+		if v.Flag_Ytsaurus_Cypress == nil {
+			v.Flag_Ytsaurus_Cypress = &dbtesterpb.Flag_Ytsaurus_Cypress{}
+		}
+		if v.Flag_Ytsaurus_Cypress.RPCProxy == "" && len(v.PeerIPs) > 0 {
+			v.Flag_Ytsaurus_Cypress.RPCProxy = fmt.Sprintf("%s:%d", v.PeerIPs[0], v.DatabasePortToConnect)
+		}
+		if v.Flag_Ytsaurus_Cypress.TokenEnv == "" {
+			v.Flag_Ytsaurus_Cypress.TokenEnv = defaultYTsaurusTokenEnv
+		}
+		if v.Flag_Ytsaurus_Cypress.RootPath == "" {
+			v.Flag_Ytsaurus_Cypress.RootPath = defaultYTsaurusRootPath
+		}
+		if v.Flag_Ytsaurus_Cypress.StorageMode == "" {
+			v.Flag_Ytsaurus_Cypress.StorageMode = defaultYTsaurusStorageMode
+		}
+		switch v.Flag_Ytsaurus_Cypress.StorageMode {
+		case ytsaurusStorageAttribute, ytsaurusStorageDocument:
+		default:
+			return nil, fmt.Errorf("unknown YTsaurus storage mode %q", v.Flag_Ytsaurus_Cypress.StorageMode)
+		}
+		if !analyze {
+			if err = readYTsaurusToken(v.Flag_Ytsaurus_Cypress); err != nil {
+				return nil, err
+			}
+		}
+		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_ytsaurus_cypress.String()] = v
+	}
+
 	// need etcd configs since it's backed by etcd
 	if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zetcd__beta.String()]; ok {
 		_, okOther := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()]
@@ -296,6 +607,22 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 	return &cfg, nil
 }
 
+// readYTsaurusToken reads the token from 'TokenPath' or 'TokenEnv' on
+// this machine. The token is never sent to the agents, so each load
+// generator reads its own.
+func readYTsaurusToken(flag *dbtesterpb.Flag_Ytsaurus_Cypress) error {
+	if flag.TokenPath == "" {
+		flag.Token = os.Getenv(flag.TokenEnv)
+		return nil
+	}
+	bts, err := ioutil.ReadFile(flag.TokenPath)
+	if err != nil {
+		return err
+	}
+	flag.Token = strings.TrimSpace(string(bts))
+	return nil
+}
+
 const maxEtcdQuotaSize = 8000000000
 
 // ToRequest converts configuration to 'dbtesterpb.Request'.
@@ -380,6 +707,15 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 	case dbtesterpb.DatabaseID_zetcd__beta:
 	case dbtesterpb.DatabaseID_cetcd__beta:
 
+	case dbtesterpb.DatabaseID_ytsaurus_cypress:
+		// token is not sent over the insecure connection,
+		// since database agents do not access the database
+		req.Flag_Ytsaurus_Cypress = &dbtesterpb.Flag_Ytsaurus_Cypress{
+			RPCProxy:    gcfg.Flag_Ytsaurus_Cypress.RPCProxy,
+			RootPath:    gcfg.Flag_Ytsaurus_Cypress.RootPath,
+			StorageMode: gcfg.Flag_Ytsaurus_Cypress.StorageMode,
+		}
+
 	default:
 		err = fmt.Errorf("unknown %v", req.DatabaseID)
 	}
@@ -492,6 +492,7 @@ func TestConfig(t *testing.T) {
 			},
 		},
 	}
+	expected.lg = cfg.lg
 	if !reflect.DeepEqual(cfg, expected) {
 		t.Fatalf("configuration expected\n%+v\n, got\n%+v\n", expected, cfg)
 	}
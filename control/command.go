@@ -16,6 +16,7 @@
 package control
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -44,6 +45,7 @@ var databaseID string
 var configPath string
 var diskDevice string
 var networkInterface string
+var metricsAddress string
 
 func init() {
 	dn, err := df.GetDevice("/")
@@ -65,6 +67,7 @@ func init() {
 	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
 	Command.PersistentFlags().StringVar(&diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
+	Command.PersistentFlags().StringVar(&metricsAddress, "metrics-address", "", "Address to serve live Prometheus metrics at '/metrics' during tests (e.g. ':9090'), empty to disable.")
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
@@ -80,12 +83,25 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	if !ok {
 		return fmt.Errorf("%q is not found", databaseID)
 	}
+	if metricsAddress != "" {
+		if err = cfg.ServeMetrics(metricsAddress); err != nil {
+			return err
+		}
+	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
 		switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 		case "write":
 		case "read":
 		case "read-oneshot":
+		case "mixed":
+		case "delete":
+		case "range":
+		case "watch":
+		case "txn":
+		case "lease":
+		case "register":
+		case "replay":
 		default:
 			return fmt.Errorf("%q is not supported", gcfg.ConfigClientMachineBenchmarkOptions.Type)
 		}
@@ -172,13 +188,20 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// verifyErr fails the test after the remaining steps
+	var verifyErr error
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
 		println()
 		time.Sleep(5 * time.Second)
 		println()
 		lg.Info("step 2: starting tests...")
 		if err = cfg.Stress(databaseID); err != nil {
-			return err
+			if !errors.Is(err, dbtester.ErrVerifyWrites) {
+				return err
+			}
+			// still stop the databases and upload the results
+			lg.Warn("write verification failed", zap.Error(err))
+			verifyErr = err
 		}
 	}
 
@@ -236,17 +259,46 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath); err != nil {
 			return err
 		}
+		if err = cfg.UploadToGoogle(databaseID, dbtester.LatencyHistogramPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath)); err != nil {
+			return err
+		}
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath); err != nil {
 			return err
 		}
+		if err = cfg.UploadToGoogle(databaseID, dbtester.ErrorSamplesPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+			return err
+		}
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath); err != nil {
 			return err
 		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.SLOSearch != nil {
+			if err = cfg.UploadToGoogle(databaseID, dbtester.SLOSearchPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.VerifyWrites {
+			if err = cfg.UploadToGoogle(databaseID, dbtester.VerifyWritesPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.RecordTrace {
+			if err = cfg.UploadToGoogle(databaseID, dbtester.TracePath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.Type == "register" {
+			if err = cfg.UploadToGoogle(databaseID, dbtester.HistoryPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+				return err
+			}
+		}
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath); err != nil {
 			return err
 		}
 	}
 
+	if verifyErr != nil {
+		return verifyErr
+	}
 	lg.Info("all done!")
 	return nil
 }
@@ -11,6 +11,7 @@
 		dbtesterpb/flag_cetcd.proto
 		dbtesterpb/flag_consul.proto
 		dbtesterpb/flag_etcd.proto
+		dbtesterpb/flag_ytsaurus.proto
 		dbtesterpb/flag_zetcd.proto
 		dbtesterpb/flag_zookeeper.proto
 		dbtesterpb/message.proto
@@ -23,6 +24,8 @@
 		ConfigAnalyzeMachineREADME
 		ConfigClientMachineInitial
 		ConfigClientMachineBenchmarkOptions
+		ConfigClientMachineSLOSearch
+		ConfigClientMachineLoadProfileStage
 		ConfigClientMachineBenchmarkSteps
 		ConfigClientMachineAgentControl
 		Flag_Cetcd_Beta
@@ -31,6 +34,7 @@
 		Flag_Etcd_Tip
 		Flag_Etcd_V3_2
 		Flag_Etcd_V3_3
+		Flag_Ytsaurus_Cypress
 		Flag_Zetcd_Beta
 		Flag_Zookeeper_R3_5_3Beta
 		Request
@@ -1775,68 +1779,68 @@ func init() {
 }
 
 var fileDescriptorConfigAnalyzeMachine = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0xaf, 0x9b, 0x26, 0x90, 0x49, 0xd3, 0x96, 0x01, 0xb5, 0x4b, 0x82, 0xd6, 0xc1, 0x69, 0x48,
-	0xaa, 0x42, 0x52, 0x12, 0x28, 0x12, 0x27, 0x76, 0xb3, 0x3d, 0x44, 0x34, 0x10, 0x39, 0x0b, 0x84,
-	0xd3, 0x68, 0xec, 0x9d, 0x78, 0x47, 0xf1, 0x3f, 0x79, 0xc6, 0x65, 0x0d, 0x57, 0x24, 0x24, 0x24,
-	0x24, 0xb8, 0x71, 0xe2, 0xc8, 0xb3, 0xf4, 0xc8, 0x13, 0x18, 0x08, 0x6f, 0xe0, 0x17, 0xa0, 0x9a,
-	0x6f, 0x9c, 0xc4, 0xbb, 0xf1, 0xfe, 0xb9, 0xad, 0xe7, 0xfb, 0xfd, 0xfb, 0x3e, 0xcf, 0x8e, 0x07,
-	0x6d, 0xf6, 0x1c, 0xc9, 0x84, 0x64, 0x49, 0xec, 0xec, 0xb8, 0x51, 0x78, 0xca, 0x3d, 0x42, 0x43,
-	0xea, 0x67, 0xdf, 0x33, 0x12, 0x50, 0xb7, 0xcf, 0x43, 0xb6, 0x1d, 0x27, 0x91, 0x8c, 0x30, 0xba,
-	0x02, 0xae, 0x7c, 0xe0, 0x71, 0xd9, 0x4f, 0x9d, 0x6d, 0x37, 0x0a, 0x76, 0xbc, 0xc8, 0x8b, 0x76,
-	0x00, 0xe2, 0xa4, 0xa7, 0xf0, 0x04, 0x0f, 0xf0, 0x4b, 0x53, 0xad, 0xbf, 0x97, 0xd1, 0xea, 0x3e,
-	0x68, 0xb7, 0xb4, 0xf4, 0xa1, 0x56, 0x3e, 0x08, 0xb9, 0xe4, 0xd4, 0xc7, 0x4d, 0x84, 0x3a, 0x54,
-	0x52, 0x87, 0x0a, 0x76, 0xd0, 0x69, 0x18, 0x6b, 0xc6, 0xd6, 0xa2, 0x5d, 0x59, 0xc1, 0x6b, 0x68,
-	0xe9, 0xe2, 0xa9, 0x4b, 0xbd, 0xc6, 0x4d, 0x00, 0x54, 0x97, 0xf0, 0x13, 0xf4, 0xe6, 0xc5, 0x63,
-	0x87, 0x09, 0x37, 0xe1, 0xb1, 0xe4, 0x51, 0xd8, 0x98, 0x03, 0x64, 0x5d, 0x09, 0x3f, 0x45, 0xe8,
-	0x88, 0xca, 0xfe, 0x51, 0xc2, 0x4e, 0xf9, 0xa0, 0x71, 0x4b, 0x01, 0xdb, 0xf7, 0x8b, 0xdc, 0xc4,
-	0x19, 0x0d, 0xfc, 0x4f, 0xad, 0x98, 0xca, 0x3e, 0x89, 0xa1, 0x68, 0xd9, 0x15, 0x24, 0xfe, 0xd1,
-	0x40, 0xeb, 0xfb, 0x3e, 0x67, 0xa1, 0x3c, 0xce, 0x84, 0x64, 0xc1, 0x21, 0x93, 0x09, 0x77, 0xc5,
-	0x41, 0xa8, 0x26, 0x13, 0xf9, 0x54, 0xb2, 0x9e, 0x42, 0x37, 0xe6, 0x41, 0x71, 0xb7, 0xc8, 0xcd,
-	0x6d, 0xad, 0xe8, 0x02, 0x89, 0x08, 0x60, 0x91, 0x40, 0xd3, 0x08, 0xaf, 0xf0, 0x88, 0x32, 0xb5,
-	0xec, 0x59, 0xe4, 0xf1, 0xcf, 0x06, 0xda, 0xd0, 0xb8, 0xe7, 0x54, 0xb2, 0xd0, 0xcd, 0xba, 0xfd,
-	0x24, 0x4a, 0xbd, 0x7e, 0x9c, 0xca, 0x2e, 0x0f, 0x98, 0x60, 0x09, 0x67, 0x02, 0x82, 0x2c, 0x40,
-	0x90, 0x8f, 0x8a, 0xdc, 0x7c, 0x32, 0x14, 0xc4, 0xd7, 0x3c, 0x22, 0x2f, 0x89, 0x44, 0x5e, 0x32,
-	0xcb, 0x28, 0xb3, 0x59, 0xe0, 0x1f, 0xd0, 0xda, 0x10, 0xb0, 0xc3, 0x85, 0x4c, 0xb8, 0x93, 0xaa,
-	0x41, 0xb7, 0x7c, 0x1f, 0x62, 0xbc, 0x06, 0x31, 0x76, 0x8a, 0xdc, 0x7c, 0x5c, 0x1b, 0xa3, 0x57,
-	0xe1, 0x10, 0xea, 0xfb, 0x65, 0x82, 0xa9, 0xc2, 0xf8, 0x57, 0x03, 0x6d, 0x8e, 0x05, 0x1d, 0xb1,
-	0xc4, 0x65, 0xa1, 0xe4, 0x3e, 0x83, 0x10, 0xaf, 0x43, 0x88, 0xa7, 0x45, 0x6e, 0xee, 0x4e, 0x0f,
-	0x11, 0x5f, 0x72, 0xcb, 0x2c, 0xb3, 0xda, 0xe0, 0x9f, 0x0c, 0xf4, 0x70, 0x2c, 0xf6, 0x38, 0x0d,
-	0x02, 0x9a, 0x64, 0x90, 0x67, 0x11, 0xf2, 0xec, 0x15, 0xb9, 0xb9, 0x33, 0x3d, 0x8f, 0xd0, 0xc4,
-	0x32, 0xcc, 0x4c, 0x06, 0x38, 0x46, 0xef, 0x0c, 0xe1, 0xda, 0xd9, 0xe7, 0x2c, 0xfb, 0x22, 0x0d,
-	0x1c, 0x96, 0x40, 0x00, 0x04, 0x01, 0xde, 0x2f, 0x72, 0x73, 0xab, 0x36, 0x80, 0x93, 0x91, 0x33,
-	0x96, 0x91, 0x10, 0x18, 0xa5, 0xf3, 0x44, 0x45, 0x9c, 0x21, 0xf3, 0x98, 0x25, 0x2f, 0x58, 0xd2,
-	0xe1, 0xe2, 0xec, 0x38, 0xa6, 0x2e, 0xfb, 0x4a, 0x50, 0x8f, 0x55, 0xbb, 0x5e, 0x1a, 0xdd, 0x0a,
-	0x02, 0x08, 0xaa, 0xdb, 0x33, 0x22, 0x14, 0x85, 0xa4, 0x8a, 0x33, 0xd2, 0xf1, 0x34, 0x5d, 0x1c,
-	0xa0, 0x55, 0x0d, 0x39, 0x64, 0x41, 0x94, 0x5c, 0xeb, 0xf5, 0x36, 0xd8, 0x3e, 0x2e, 0x72, 0x73,
-	0x73, 0xc8, 0x36, 0x00, 0x74, 0x6d, 0xab, 0x93, 0xf4, 0xd4, 0x5b, 0x5e, 0xd7, 0x75, 0x9b, 0xd1,
-	0x5e, 0x3b, 0x93, 0x4c, 0x74, 0x98, 0x2f, 0xe9, 0xa8, 0xef, 0x32, 0xf8, 0x7e, 0x5c, 0xe4, 0xe6,
-	0x87, 0x43, 0xbe, 0x09, 0xa3, 0x3d, 0xe2, 0x28, 0x1a, 0xe9, 0x29, 0x5e, 0x6d, 0x82, 0x59, 0x1c,
-	0xd4, 0x61, 0xf0, 0x50, 0xe3, 0xbe, 0x49, 0xb8, 0x64, 0xe3, 0xa3, 0xdc, 0x19, 0xdd, 0xff, 0x65,
-	0x94, 0xef, 0x14, 0x6d, 0x6a, 0x96, 0x99, 0x3c, 0xf0, 0x6f, 0x06, 0xda, 0xd4, 0xc0, 0x89, 0x27,
-	0xd8, 0x73, 0x2e, 0x64, 0xe3, 0xee, 0xda, 0xdc, 0xd6, 0x62, 0xfb, 0x93, 0x22, 0x37, 0xf7, 0x86,
-	0xf2, 0x4c, 0x3b, 0x24, 0x89, 0xcf, 0x85, 0xb4, 0xec, 0x59, 0x7d, 0x30, 0x41, 0x0f, 0x5a, 0xbe,
-	0xdf, 0xf2, 0xbc, 0x84, 0x79, 0xaa, 0xf0, 0x65, 0x2a, 0xe3, 0x54, 0xc2, 0x48, 0xee, 0xc1, 0x48,
-	0x36, 0x8a, 0xdc, 0x7c, 0x57, 0x47, 0x50, 0x67, 0x0f, 0xbd, 0x44, 0x92, 0x08, 0xa0, 0xe5, 0x04,
-	0xc6, 0xa9, 0x58, 0xff, 0xab, 0x43, 0xa8, 0xe6, 0x0b, 0x57, 0x83, 0xc7, 0x1c, 0xad, 0x8c, 0x91,
-	0xd9, 0x3f, 0xfe, 0x5a, 0x7f, 0xfd, 0xda, 0x8f, 0x8a, 0xdc, 0xdc, 0x98, 0x96, 0x87, 0xb8, 0xe2,
-	0x85, 0x65, 0x4f, 0x10, 0x9b, 0x60, 0xd5, 0x3d, 0xe9, 0xea, 0xef, 0xe8, 0x8c, 0x56, 0x72, 0x20,
-	0xc7, 0x5b, 0x75, 0x4f, 0xba, 0xd6, 0x1f, 0x37, 0x51, 0xa3, 0x6e, 0x02, 0x47, 0x7e, 0x24, 0xf1,
-	0x23, 0xb4, 0xb0, 0x1f, 0xf9, 0x69, 0x10, 0x96, 0xed, 0xbd, 0x51, 0xe4, 0xe6, 0x72, 0x79, 0xe0,
-	0xc0, 0xba, 0x65, 0x97, 0x00, 0xbc, 0x89, 0xe6, 0x4f, 0x5a, 0x03, 0x2e, 0xca, 0x74, 0x15, 0xe4,
-	0x80, 0xd0, 0x01, 0x17, 0x96, 0xad, 0xeb, 0x0a, 0xf8, 0x2d, 0x00, 0xe7, 0x46, 0x81, 0xd9, 0x05,
-	0x10, 0xea, 0xf8, 0x33, 0xb4, 0x3c, 0x3c, 0x62, 0xfd, 0xb1, 0x5f, 0x29, 0x72, 0xf3, 0xbe, 0x26,
-	0x5c, 0x9b, 0xe9, 0x30, 0x01, 0xef, 0xa3, 0x3b, 0x57, 0x0b, 0xb0, 0x71, 0xe7, 0x61, 0xe3, 0xae,
-	0x16, 0xb9, 0xf9, 0xe0, 0xba, 0x84, 0xde, 0x9c, 0x23, 0x14, 0xeb, 0x17, 0x03, 0xbd, 0x5d, 0x7b,
-	0x09, 0x0a, 0xa8, 0xc7, 0xf0, 0x7b, 0x68, 0xbe, 0xcb, 0xa5, 0xcf, 0xca, 0x01, 0xdd, 0x2b, 0x72,
-	0xf3, 0xb6, 0x56, 0x96, 0x6a, 0xd9, 0xb2, 0x75, 0x19, 0xaf, 0xa3, 0x5b, 0xb0, 0x6d, 0xf5, 0x74,
-	0xee, 0x16, 0xb9, 0xb9, 0x74, 0x75, 0x61, 0xb1, 0x6c, 0x28, 0x2a, 0x50, 0x37, 0x8b, 0x59, 0x39,
-	0x99, 0x0a, 0x48, 0x66, 0x31, 0xb3, 0x6c, 0x28, 0x5a, 0x7f, 0x1a, 0x68, 0xa5, 0x2e, 0x8f, 0xfd,
-	0xac, 0xd5, 0x39, 0x7c, 0xa6, 0xee, 0x47, 0x95, 0x7f, 0x89, 0x31, 0x7a, 0x3f, 0x1a, 0xfa, 0x5b,
-	0x54, 0x90, 0xf8, 0x08, 0x2d, 0x40, 0x47, 0xea, 0x05, 0xce, 0x6d, 0x2d, 0xed, 0x6e, 0x6c, 0x5f,
-	0xdd, 0x1b, 0xb7, 0xc7, 0xf6, 0x5f, 0x7d, 0x7d, 0x1c, 0xe8, 0x96, 0x5d, 0xea, 0xb4, 0xdf, 0x7a,
-	0xf9, 0x6f, 0xf3, 0xc6, 0xcb, 0xf3, 0xa6, 0xf1, 0xd7, 0x79, 0xd3, 0xf8, 0xe7, 0xbc, 0x69, 0xfc,
-	0xfe, 0x5f, 0xf3, 0x86, 0xb3, 0x00, 0x57, 0xcb, 0xbd, 0x57, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47,
-	0x8f, 0xbe, 0x24, 0xc0, 0x0a, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6e, 0xdc, 0x44,
+	0x18, 0xaf, 0x93, 0x26, 0xd0, 0x49, 0xd3, 0x96, 0x01, 0xb5, 0x26, 0x41, 0xeb, 0xe0, 0x34, 0x24,
+	0x55, 0x21, 0x29, 0x09, 0x14, 0x89, 0x13, 0xbb, 0xd9, 0x1e, 0x22, 0x1a, 0x88, 0x9c, 0x05, 0xc2,
+	0x69, 0x34, 0xf6, 0x4e, 0xbc, 0xa3, 0xf8, 0x9f, 0x3c, 0xe3, 0xb2, 0x86, 0x2b, 0x12, 0x12, 0x12,
+	0x12, 0xdc, 0x38, 0x71, 0xe4, 0x59, 0x7a, 0xe4, 0x09, 0x0c, 0x84, 0x37, 0xf0, 0x0b, 0x80, 0xe6,
+	0x1b, 0x27, 0xd9, 0xdd, 0x78, 0xff, 0x70, 0x5b, 0xcf, 0xf7, 0xfb, 0xf7, 0x7d, 0x9e, 0x1d, 0x0f,
+	0xda, 0xec, 0xba, 0x92, 0x09, 0xc9, 0xd2, 0xc4, 0xdd, 0xf1, 0xe2, 0xe8, 0x94, 0xfb, 0x84, 0x46,
+	0x34, 0xc8, 0xbf, 0x65, 0x24, 0xa4, 0x5e, 0x8f, 0x47, 0x6c, 0x3b, 0x49, 0x63, 0x19, 0x63, 0x74,
+	0x05, 0x5c, 0x79, 0xcf, 0xe7, 0xb2, 0x97, 0xb9, 0xdb, 0x5e, 0x1c, 0xee, 0xf8, 0xb1, 0x1f, 0xef,
+	0x00, 0xc4, 0xcd, 0x4e, 0xe1, 0x09, 0x1e, 0xe0, 0x97, 0xa6, 0xda, 0x7f, 0x2e, 0xa3, 0xd5, 0x7d,
+	0xd0, 0x6e, 0x6a, 0xe9, 0x43, 0xad, 0x7c, 0x10, 0x71, 0xc9, 0x69, 0x80, 0x1b, 0x08, 0xb5, 0xa9,
+	0xa4, 0x2e, 0x15, 0xec, 0xa0, 0x6d, 0x1a, 0x6b, 0xc6, 0xd6, 0x2d, 0x67, 0x60, 0x05, 0xaf, 0xa1,
+	0xa5, 0x8b, 0xa7, 0x0e, 0xf5, 0xcd, 0x39, 0x00, 0x0c, 0x2e, 0xe1, 0x27, 0xe8, 0xf5, 0x8b, 0xc7,
+	0x36, 0x13, 0x5e, 0xca, 0x13, 0xc9, 0xe3, 0xc8, 0x9c, 0x07, 0x64, 0x5d, 0x09, 0x3f, 0x45, 0xe8,
+	0x88, 0xca, 0xde, 0x51, 0xca, 0x4e, 0x79, 0xdf, 0xbc, 0xa9, 0x80, 0xad, 0xfb, 0x65, 0x61, 0xe1,
+	0x9c, 0x86, 0xc1, 0xc7, 0x76, 0x42, 0x65, 0x8f, 0x24, 0x50, 0xb4, 0x9d, 0x01, 0x24, 0xfe, 0xde,
+	0x40, 0xeb, 0xfb, 0x01, 0x67, 0x91, 0x3c, 0xce, 0x85, 0x64, 0xe1, 0x21, 0x93, 0x29, 0xf7, 0xc4,
+	0x41, 0xa4, 0x26, 0x13, 0x07, 0x54, 0xb2, 0xae, 0x42, 0x9b, 0x0b, 0xa0, 0xb8, 0x5b, 0x16, 0xd6,
+	0xb6, 0x56, 0xf4, 0x80, 0x44, 0x04, 0xb0, 0x48, 0xa8, 0x69, 0x84, 0x0f, 0xf0, 0x88, 0x32, 0xb5,
+	0x9d, 0x59, 0xe4, 0xf1, 0x8f, 0x06, 0xda, 0xd0, 0xb8, 0xe7, 0x54, 0xb2, 0xc8, 0xcb, 0x3b, 0xbd,
+	0x34, 0xce, 0xfc, 0x5e, 0x92, 0xc9, 0x0e, 0x0f, 0x99, 0x60, 0x29, 0x67, 0x02, 0x82, 0x2c, 0x42,
+	0x90, 0x0f, 0xca, 0xc2, 0x7a, 0x32, 0x14, 0x24, 0xd0, 0x3c, 0x22, 0x2f, 0x89, 0x44, 0x5e, 0x32,
+	0xab, 0x28, 0xb3, 0x59, 0xe0, 0xef, 0xd0, 0xda, 0x10, 0xb0, 0xcd, 0x85, 0x4c, 0xb9, 0x9b, 0xa9,
+	0x41, 0x37, 0x83, 0x00, 0x62, 0xbc, 0x02, 0x31, 0x76, 0xca, 0xc2, 0x7a, 0x5c, 0x1b, 0xa3, 0x3b,
+	0xc0, 0x21, 0x34, 0x08, 0xaa, 0x04, 0x53, 0x85, 0xf1, 0xcf, 0x06, 0xda, 0x1c, 0x0b, 0x3a, 0x62,
+	0xa9, 0xc7, 0x22, 0xc9, 0x03, 0x06, 0x21, 0x5e, 0x85, 0x10, 0x4f, 0xcb, 0xc2, 0xda, 0x9d, 0x1e,
+	0x22, 0xb9, 0xe4, 0x56, 0x59, 0x66, 0xb5, 0xc1, 0x3f, 0x18, 0xe8, 0xe1, 0x58, 0xec, 0x71, 0x16,
+	0x86, 0x34, 0xcd, 0x21, 0xcf, 0x2d, 0xc8, 0xb3, 0x57, 0x16, 0xd6, 0xce, 0xf4, 0x3c, 0x42, 0x13,
+	0xab, 0x30, 0x33, 0x19, 0xe0, 0x04, 0xbd, 0x35, 0x84, 0x6b, 0xe5, 0x9f, 0xb2, 0xfc, 0xb3, 0x2c,
+	0x74, 0x59, 0x0a, 0x01, 0x10, 0x04, 0x78, 0xb7, 0x2c, 0xac, 0xad, 0xda, 0x00, 0x6e, 0x4e, 0xce,
+	0x58, 0x4e, 0x22, 0x60, 0x54, 0xce, 0x13, 0x15, 0x71, 0x8e, 0xac, 0x63, 0x96, 0xbe, 0x60, 0x69,
+	0x9b, 0x8b, 0xb3, 0xe3, 0x84, 0x7a, 0xec, 0x0b, 0x41, 0x7d, 0x36, 0xd8, 0xf5, 0xd2, 0xe8, 0x56,
+	0x10, 0x40, 0x50, 0xdd, 0x9e, 0x11, 0xa1, 0x28, 0x24, 0x53, 0x9c, 0x91, 0x8e, 0xa7, 0xe9, 0xe2,
+	0x10, 0xad, 0x6a, 0xc8, 0x21, 0x0b, 0xe3, 0xf4, 0x5a, 0xaf, 0xb7, 0xc1, 0xf6, 0x71, 0x59, 0x58,
+	0x9b, 0x43, 0xb6, 0x21, 0xa0, 0x6b, 0x5b, 0x9d, 0xa4, 0xa7, 0xde, 0xf2, 0xba, 0xae, 0x3b, 0x8c,
+	0x76, 0x5b, 0xb9, 0x64, 0xa2, 0xcd, 0x02, 0x49, 0x47, 0x7d, 0x97, 0xc1, 0xf7, 0xc3, 0xb2, 0xb0,
+	0xde, 0x1f, 0xf2, 0x4d, 0x19, 0xed, 0x12, 0x57, 0xd1, 0x48, 0x57, 0xf1, 0x6a, 0x13, 0xcc, 0xe2,
+	0xa0, 0x0e, 0x83, 0x87, 0x1a, 0xf7, 0x55, 0xca, 0x25, 0x1b, 0x1f, 0xe5, 0xce, 0xe8, 0xfe, 0xaf,
+	0xa2, 0x7c, 0xa3, 0x68, 0x53, 0xb3, 0xcc, 0xe4, 0x81, 0x7f, 0x31, 0xd0, 0xa6, 0x06, 0x4e, 0x3c,
+	0xc1, 0x9e, 0x73, 0x21, 0xcd, 0xbb, 0x6b, 0xf3, 0x5b, 0xb7, 0x5a, 0x1f, 0x95, 0x85, 0xb5, 0x37,
+	0x94, 0x67, 0xda, 0x21, 0x49, 0x02, 0x2e, 0xa4, 0xed, 0xcc, 0xea, 0x83, 0x09, 0x7a, 0xd0, 0x0c,
+	0x82, 0xa6, 0xef, 0xa7, 0xcc, 0x57, 0x85, 0xcf, 0x33, 0x99, 0x64, 0x12, 0x46, 0x72, 0x0f, 0x46,
+	0xb2, 0x51, 0x16, 0xd6, 0xdb, 0x3a, 0x82, 0x3a, 0x7b, 0xe8, 0x25, 0x92, 0xc4, 0x00, 0xad, 0x26,
+	0x30, 0x4e, 0xc5, 0xfe, 0x57, 0x1d, 0x42, 0x35, 0x5f, 0xb8, 0x1a, 0x3c, 0xe6, 0x68, 0x65, 0x8c,
+	0xcc, 0xfe, 0xf1, 0x97, 0xfa, 0xeb, 0xd7, 0x7a, 0x54, 0x16, 0xd6, 0xc6, 0xb4, 0x3c, 0xc4, 0x13,
+	0x2f, 0x6c, 0x67, 0x82, 0xd8, 0x04, 0xab, 0xce, 0x49, 0xc7, 0x9c, 0xfb, 0x1f, 0x56, 0xb2, 0x2f,
+	0xc7, 0x5b, 0x75, 0x4e, 0x3a, 0xf6, 0x6f, 0x73, 0xc8, 0xac, 0x9b, 0xc0, 0x51, 0x10, 0x4b, 0xfc,
+	0x08, 0x2d, 0xee, 0xc7, 0x41, 0x16, 0x46, 0x55, 0x7b, 0xaf, 0x95, 0x85, 0xb5, 0x5c, 0x1d, 0x38,
+	0xb0, 0x6e, 0x3b, 0x15, 0x00, 0x6f, 0xa2, 0x85, 0x93, 0x66, 0x9f, 0x0b, 0x73, 0x6e, 0x14, 0xd9,
+	0x27, 0xb4, 0xcf, 0x85, 0xed, 0xe8, 0xba, 0x02, 0x7e, 0x0d, 0xc0, 0xf9, 0x51, 0x60, 0x7e, 0x01,
+	0x84, 0x3a, 0xfe, 0x04, 0x2d, 0x0f, 0x8f, 0x58, 0x7f, 0xec, 0x57, 0xca, 0xc2, 0xba, 0xaf, 0x09,
+	0xd7, 0x66, 0x3a, 0x4c, 0xc0, 0xfb, 0xe8, 0xce, 0xd5, 0x02, 0x6c, 0xdc, 0x05, 0xd8, 0xb8, 0xab,
+	0x65, 0x61, 0x3d, 0xb8, 0x2e, 0xa1, 0x37, 0xe7, 0x08, 0xc5, 0xfe, 0xc9, 0x40, 0x6f, 0xd6, 0x5e,
+	0x82, 0x42, 0xea, 0x33, 0xfc, 0x0e, 0x5a, 0xe8, 0x70, 0x19, 0xb0, 0x6a, 0x40, 0xf7, 0xca, 0xc2,
+	0xba, 0xad, 0x95, 0xa5, 0x5a, 0xb6, 0x1d, 0x5d, 0xc6, 0xeb, 0xe8, 0x26, 0x6c, 0x5b, 0x3d, 0x9d,
+	0xbb, 0x65, 0x61, 0x2d, 0x5d, 0x5d, 0x58, 0x6c, 0x07, 0x8a, 0x0a, 0xd4, 0xc9, 0x13, 0x66, 0xce,
+	0x8f, 0x82, 0x64, 0x9e, 0x30, 0xdb, 0x81, 0xa2, 0xfd, 0xbb, 0x81, 0x56, 0xea, 0xf2, 0x38, 0xcf,
+	0x9a, 0xed, 0xc3, 0x67, 0xea, 0x7e, 0x34, 0xf0, 0x2f, 0x31, 0x46, 0xef, 0x47, 0x43, 0x7f, 0x8b,
+	0x01, 0x24, 0x3e, 0x42, 0x8b, 0xd0, 0x91, 0x7a, 0x81, 0xf3, 0x5b, 0x4b, 0xbb, 0x1b, 0xdb, 0x57,
+	0xf7, 0xc6, 0xed, 0xb1, 0xfd, 0x0f, 0xbe, 0x3e, 0x0e, 0x74, 0xdb, 0xa9, 0x74, 0x5a, 0x6f, 0xbc,
+	0xfc, 0xbb, 0x71, 0xe3, 0xe5, 0x79, 0xc3, 0xf8, 0xe3, 0xbc, 0x61, 0xfc, 0x75, 0xde, 0x30, 0x7e,
+	0xfd, 0xa7, 0x71, 0xc3, 0x5d, 0x84, 0xab, 0xe5, 0xde, 0x7f, 0x03, 0x00, 0x47, 0x8f, 0xbe, 0x24,
+	0xc0, 0x0a, 0x00, 0x00,
 }
@@ -8,6 +8,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
+import encoding_binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -53,6 +55,144 @@ type ConfigClientMachineBenchmarkOptions struct {
 	KeySizeBytes               int64   `protobuf:"varint,8,opt,name=KeySizeBytes,proto3" json:"KeySizeBytes,omitempty" yaml:"key_size_bytes"`
 	ValueSizeBytes             int64   `protobuf:"varint,9,opt,name=ValueSizeBytes,proto3" json:"ValueSizeBytes,omitempty" yaml:"value_size_bytes"`
 	StaleRead                  bool    `protobuf:"varint,10,opt,name=StaleRead,proto3" json:"StaleRead,omitempty" yaml:"stale_read"`
+	// ReadPercent is the percentage of reads in "mixed" and "register"
+	// workloads, the rest are writes.
+	ReadPercent int64 `protobuf:"varint,11,opt,name=ReadPercent,proto3" json:"ReadPercent,omitempty" yaml:"read_percent"`
+	// KeySpaceSize is the number of keys preloaded before "mixed", "range" and "txn" workloads.
+	// For "txn", it is the number of keys contended by all clients.
+	// For "register", it is the number of registers read and written
+	// with unique values, recording the history of the operations
+	// for the linearizability checker. The registers are set to "0"
+	// before the run, and the puts are recorded first in the history.
+	KeySpaceSize int64 `protobuf:"varint,12,opt,name=KeySpaceSize,proto3" json:"KeySpaceSize,omitempty" yaml:"key_space_size"`
+	// RangePrefix is the prefix of keys preloaded and listed in "range" workload.
+	RangePrefix string `protobuf:"bytes,13,opt,name=RangePrefix,proto3" json:"RangePrefix,omitempty" yaml:"range_prefix"`
+	// RangeLimit is the maximum number of keys returned by each range request.
+	// 0 to return all keys with the prefix.
+	RangeLimit int64 `protobuf:"varint,14,opt,name=RangeLimit,proto3" json:"RangeLimit,omitempty" yaml:"range_limit"`
+	// WatcherNumber is the number of watchers on the key in "watch" workload,
+	// each with its own connection.
+	WatcherNumber int64 `protobuf:"varint,15,opt,name=WatcherNumber,proto3" json:"WatcherNumber,omitempty" yaml:"watcher_number"`
+	// LeaseTTLSeconds is the TTL of each lease in "lease" workload,
+	// with one lease per client.
+	LeaseTTLSeconds int64 `protobuf:"varint,16,opt,name=LeaseTTLSeconds,proto3" json:"LeaseTTLSeconds,omitempty" yaml:"lease_ttl_seconds"`
+	// LeaseKeepAliveSeconds is how long the leases are kept alive
+	// before they are left to expire.
+	LeaseKeepAliveSeconds int64 `protobuf:"varint,17,opt,name=LeaseKeepAliveSeconds,proto3" json:"LeaseKeepAliveSeconds,omitempty" yaml:"lease_keep_alive_seconds"`
+	// KeyDistribution is how "write", "read", "mixed" and "txn" workloads
+	// pick keys out of 'KeySpaceSize' keys: "sequential", "uniform",
+	// "zipfian", "hotspot" or "latest". Empty to keep the default
+	// of each workload.
+	KeyDistribution string `protobuf:"bytes,18,opt,name=KeyDistribution,proto3" json:"KeyDistribution,omitempty" yaml:"key_distribution"`
+	// ZipfianSkew is the skew of "zipfian" and "latest" distributions,
+	// between 0 and 1 (exclusive).
+	ZipfianSkew float64 `protobuf:"fixed64,19,opt,name=ZipfianSkew,proto3" json:"ZipfianSkew,omitempty" yaml:"zipfian_skew"`
+	// HotspotKeyPercent is the percentage of keys that are hot
+	// in "hotspot" distribution.
+	HotspotKeyPercent int64 `protobuf:"varint,20,opt,name=HotspotKeyPercent,proto3" json:"HotspotKeyPercent,omitempty" yaml:"hotspot_key_percent"`
+	// HotspotRequestPercent is the percentage of requests
+	// to the hot keys in "hotspot" distribution.
+	HotspotRequestPercent int64 `protobuf:"varint,21,opt,name=HotspotRequestPercent,proto3" json:"HotspotRequestPercent,omitempty" yaml:"hotspot_request_percent"`
+	// RandomSeed seeds the random key and operation choices.
+	// 0 to seed with the start time, which is recorded in the results.
+	RandomSeed int64 `protobuf:"varint,22,opt,name=RandomSeed,proto3" json:"RandomSeed,omitempty" yaml:"random_seed"`
+	// ValueSizeDistribution is how value sizes are drawn: "fixed"
+	// ('ValueSizeBytes'), "uniform" (between 'ValueSizeMinBytes' and
+	// 'ValueSizeMaxBytes'), "normal" (mean 'ValueSizeBytes' with
+	// 'ValueSizeStddevBytes') or "histogram" ('ValueSizeHistogramPath').
+	// Empty for "fixed".
+	ValueSizeDistribution string `protobuf:"bytes,23,opt,name=ValueSizeDistribution,proto3" json:"ValueSizeDistribution,omitempty" yaml:"value_size_distribution"`
+	ValueSizeMinBytes     int64  `protobuf:"varint,24,opt,name=ValueSizeMinBytes,proto3" json:"ValueSizeMinBytes,omitempty" yaml:"value_size_min_bytes"`
+	ValueSizeMaxBytes     int64  `protobuf:"varint,25,opt,name=ValueSizeMaxBytes,proto3" json:"ValueSizeMaxBytes,omitempty" yaml:"value_size_max_bytes"`
+	ValueSizeStddevBytes  int64  `protobuf:"varint,26,opt,name=ValueSizeStddevBytes,proto3" json:"ValueSizeStddevBytes,omitempty" yaml:"value_size_stddev_bytes"`
+	// ValueSizeHistogramPath is the CSV file of "size_bytes,weight" lines
+	// for "histogram" distribution.
+	ValueSizeHistogramPath string `protobuf:"bytes,27,opt,name=ValueSizeHistogramPath,proto3" json:"ValueSizeHistogramPath,omitempty" yaml:"value_size_histogram_path"`
+	// ValuePoolSize is the number of distinct values written in turn.
+	ValuePoolSize int64 `protobuf:"varint,28,opt,name=ValuePoolSize,proto3" json:"ValuePoolSize,omitempty" yaml:"value_pool_size"`
+	// ValueCompressibility is the fraction of each value filled with
+	// a repeated byte, between 0 (random) and 1 (exclusive).
+	ValueCompressibility float64 `protobuf:"fixed64,29,opt,name=ValueCompressibility,proto3" json:"ValueCompressibility,omitempty" yaml:"value_compressibility"`
+	// DurationSeconds stops the benchmark after the duration. With non-zero
+	// 'RequestNumber', it stops on whichever comes first. 0 for no deadline.
+	DurationSeconds int64 `protobuf:"varint,30,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty" yaml:"duration_seconds"`
+	// OpenLoop schedules the requests at fixed intervals of
+	// 'RateLimitRequestsPerSecond' regardless of the responses, and measures
+	// the latency from the scheduled send time, to correct coordinated omission.
+	OpenLoop bool `protobuf:"varint,31,opt,name=OpenLoop,proto3" json:"OpenLoop,omitempty" yaml:"open_loop"`
+	// WarmupSeconds and WarmupRequests exclude the requests started in the
+	// first seconds, or the first requests, from the summary, percentile and
+	// distribution results. The time series still include them, marked with
+	// "warmup" phase. With 'ConnectionClientNumbers', applied to each range.
+	WarmupSeconds  int64 `protobuf:"varint,32,opt,name=WarmupSeconds,proto3" json:"WarmupSeconds,omitempty" yaml:"warmup_seconds"`
+	WarmupRequests int64 `protobuf:"varint,33,opt,name=WarmupRequests,proto3" json:"WarmupRequests,omitempty" yaml:"warmup_requests"`
+	// CooldownSeconds and CooldownRequests exclude the requests started in the
+	// last seconds, or the last requests, the same as warm-up with "cooldown" phase.
+	CooldownSeconds  int64 `protobuf:"varint,34,opt,name=CooldownSeconds,proto3" json:"CooldownSeconds,omitempty" yaml:"cooldown_seconds"`
+	CooldownRequests int64 `protobuf:"varint,35,opt,name=CooldownRequests,proto3" json:"CooldownRequests,omitempty" yaml:"cooldown_requests"`
+	// RequestTimeoutMilliseconds fails each request not finished within
+	// the timeout with "request timeout" error. 0 for no timeout.
+	RequestTimeoutMilliseconds int64 `protobuf:"varint,36,opt,name=RequestTimeoutMilliseconds,proto3" json:"RequestTimeoutMilliseconds,omitempty" yaml:"request_timeout_milliseconds"`
+	// RetryMaxAttempts retries each failed request up to the number of
+	// attempts, including the first one, as applications do on transient
+	// errors. The latency includes all attempts and backoffs. 0 or 1 for
+	// no retry.
+	RetryMaxAttempts int64 `protobuf:"varint,37,opt,name=RetryMaxAttempts,proto3" json:"RetryMaxAttempts,omitempty" yaml:"retry_max_attempts"`
+	// RetryBackoffMilliseconds is the wait before the first retry,
+	// doubled on each retry up to 'RetryMaxBackoffMilliseconds' (0 for no limit).
+	RetryBackoffMilliseconds    int64 `protobuf:"varint,38,opt,name=RetryBackoffMilliseconds,proto3" json:"RetryBackoffMilliseconds,omitempty" yaml:"retry_backoff_milliseconds"`
+	RetryMaxBackoffMilliseconds int64 `protobuf:"varint,39,opt,name=RetryMaxBackoffMilliseconds,proto3" json:"RetryMaxBackoffMilliseconds,omitempty" yaml:"retry_max_backoff_milliseconds"`
+	// RetryErrorCategories are the error categories to retry (e.g. "timeout",
+	// "no-leader"). Empty for "timeout", "unavailable", "no-leader" and
+	// "connection-reset".
+	RetryErrorCategories []string `protobuf:"bytes,40,rep,name=RetryErrorCategories" json:"RetryErrorCategories,omitempty" yaml:"retry_error_categories"`
+	// LoadProfile is the target request rate over time, in place of the
+	// constant 'RateLimitRequestsPerSecond'. The stages run in order, and
+	// the rate at the end of the last stage holds until the benchmark ends.
+	LoadProfile []*ConfigClientMachineLoadProfileStage `protobuf:"bytes,41,rep,name=LoadProfile" json:"LoadProfile,omitempty" yaml:"load_profile"`
+	// SLOSearch searches for the highest sustained request rate under the
+	// latency and error thresholds, in place of a single run. Only for
+	// "write" and "read" workloads.
+	SLOSearch *ConfigClientMachineSLOSearch `protobuf:"bytes,42,opt,name=SLOSearch" json:"SLOSearch,omitempty" yaml:"slo_search"`
+	// VerifyWrites reads back the keys acknowledged by "write" workload from
+	// each endpoint after the run, and fails the test on the missing keys
+	// or the values other than written. Consul reads are served by the leader,
+	// so each Consul endpoint reports the leader state. YTsaurus is verified
+	// once through the RPC proxy.
+	VerifyWrites bool `protobuf:"varint,43,opt,name=VerifyWrites,proto3" json:"VerifyWrites,omitempty" yaml:"verify_writes"`
+	// VerifySampleSize verifies the random sample of the written keys.
+	// 0 to verify all keys.
+	VerifySampleSize int64 `protobuf:"varint,44,opt,name=VerifySampleSize,proto3" json:"VerifySampleSize,omitempty" yaml:"verify_sample_size"`
+	// RecordTrace records the requests sent by the workload to the trace
+	// file next to the latency summary, with the time since the first
+	// request, the key, and the size and hash of the value, to be replayed
+	// by "replay" workload.
+	RecordTrace bool `protobuf:"varint,45,opt,name=RecordTrace,proto3" json:"RecordTrace,omitempty" yaml:"record_trace"`
+	// ReplayTracePath is the trace file replayed by "replay" workload.
+	// The requests are sent at the recorded times, with the values of the
+	// recorded sizes. 'KeySpaceSize' keys with 'RangePrefix' are preloaded
+	// if not zero, for the traces of the workloads reading preloaded keys.
+	ReplayTracePath string `protobuf:"bytes,46,opt,name=ReplayTracePath,proto3" json:"ReplayTracePath,omitempty" yaml:"replay_trace_path"`
+	// ReplaySpeedup divides the recorded times between the requests
+	// (e.g. 2 to replay twice faster). 0 or 1 for the recorded times.
+	ReplaySpeedup float64 `protobuf:"fixed64,47,opt,name=ReplaySpeedup,proto3" json:"ReplaySpeedup,omitempty" yaml:"replay_speedup"`
+	// TxnMode is how "txn" workload updates the keys on ZooKeeper and Consul:
+	// "cas" (default) for the conditional update of the key (ZooKeeper
+	// versioned "Set", Consul "CAS"), or "multi" for the transaction of
+	// the version check and the update (ZooKeeper "Multi", Consul "Txn").
+	// etcd always uses "Txn", and the other databases ignore it.
+	TxnMode string `protobuf:"bytes,48,opt,name=TxnMode,proto3" json:"TxnMode,omitempty" yaml:"txn_mode"`
+	// WatchMode is what "watch" workload watches: "key" (default) for the
+	// changes of the key (etcd "Watch", ZooKeeper "GetW", Consul blocking
+	// query of the key), or "children" for the children created under the
+	// key (etcd "Watch" with prefix, ZooKeeper "ChildrenW", Consul blocking
+	// query of the keys with the prefix), each write creating a new child.
+	WatchMode string `protobuf:"bytes,49,opt,name=WatchMode,proto3" json:"WatchMode,omitempty" yaml:"watch_mode"`
+	// WatcherNumbers runs "watch" workload once for each number of watchers,
+	// in place of 'WatcherNumber', to measure the fan-out scaling. Each run
+	// sends 'RequestNumber' writes, and the results of each run are saved
+	// next to the summary of all runs.
+	WatcherNumbers []int64 `protobuf:"varint,50,rep,packed,name=WatcherNumbers" json:"WatcherNumbers,omitempty" yaml:"watcher_numbers"`
 }
 
 func (m *ConfigClientMachineBenchmarkOptions) Reset()         { *m = ConfigClientMachineBenchmarkOptions{} }
@@ -62,6 +202,60 @@ func (*ConfigClientMachineBenchmarkOptions) Descriptor() ([]byte, []int) {
 	return fileDescriptorConfigClientMachine, []int{1}
 }
 
+// ConfigClientMachineSLOSearch is the binary search of request rates,
+// each run for 'ProbeSeconds' with 'ClientNumber' clients.
+type ConfigClientMachineSLOSearch struct {
+	// LatencyPercentile is the percentile of latency (e.g. 99) to keep
+	// under 'MaxLatencyMilliseconds'.
+	LatencyPercentile      float64 `protobuf:"fixed64,1,opt,name=LatencyPercentile,proto3" json:"LatencyPercentile,omitempty" yaml:"latency_percentile"`
+	MaxLatencyMilliseconds float64 `protobuf:"fixed64,2,opt,name=MaxLatencyMilliseconds,proto3" json:"MaxLatencyMilliseconds,omitempty" yaml:"max_latency_milliseconds"`
+	// MaxErrorPercent is the highest percentage of failed requests.
+	MaxErrorPercent float64 `protobuf:"fixed64,3,opt,name=MaxErrorPercent,proto3" json:"MaxErrorPercent,omitempty" yaml:"max_error_percent"`
+	// MinThroughputPercent is the lowest throughput, in percentage of
+	// the probed rate, for the rate to be sustained. 0 for 90.
+	MinThroughputPercent float64 `protobuf:"fixed64,4,opt,name=MinThroughputPercent,proto3" json:"MinThroughputPercent,omitempty" yaml:"min_throughput_percent"`
+	// ProbeSeconds is the duration of each probe, including
+	// 'WarmupSeconds' and 'CooldownSeconds'.
+	ProbeSeconds int64 `protobuf:"varint,5,opt,name=ProbeSeconds,proto3" json:"ProbeSeconds,omitempty" yaml:"probe_seconds"`
+	// MinRequestsPerSecond and MaxRequestsPerSecond bound the search.
+	MinRequestsPerSecond int64 `protobuf:"varint,6,opt,name=MinRequestsPerSecond,proto3" json:"MinRequestsPerSecond,omitempty" yaml:"min_requests_per_second"`
+	MaxRequestsPerSecond int64 `protobuf:"varint,7,opt,name=MaxRequestsPerSecond,proto3" json:"MaxRequestsPerSecond,omitempty" yaml:"max_requests_per_second"`
+	// ResolutionRequestsPerSecond stops the search when the highest
+	// passed and the lowest failed rates are this close.
+	ResolutionRequestsPerSecond int64 `protobuf:"varint,8,opt,name=ResolutionRequestsPerSecond,proto3" json:"ResolutionRequestsPerSecond,omitempty" yaml:"resolution_requests_per_second"`
+}
+
+func (m *ConfigClientMachineSLOSearch) Reset()         { *m = ConfigClientMachineSLOSearch{} }
+func (m *ConfigClientMachineSLOSearch) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineSLOSearch) ProtoMessage()    {}
+func (*ConfigClientMachineSLOSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{2}
+}
+
+// ConfigClientMachineLoadProfileStage is a stage of the target request rate.
+type ConfigClientMachineLoadProfileStage struct {
+	// Type is "step" (constant 'RequestsPerSecond'), "ramp" (linear from
+	// 'StartRequestsPerSecond' to 'RequestsPerSecond'), "sine"
+	// ('RequestsPerSecond' plus 'AmplitudeRequestsPerSecond' times the sine
+	// of 'PeriodSeconds'), or "spike" ('RequestsPerSecond', jumping to
+	// 'PeakRequestsPerSecond' for 'SpikeSeconds' in the middle of the stage).
+	Type                       string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
+	DurationSeconds            int64  `protobuf:"varint,2,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty" yaml:"duration_seconds"`
+	RequestsPerSecond          int64  `protobuf:"varint,3,opt,name=RequestsPerSecond,proto3" json:"RequestsPerSecond,omitempty" yaml:"requests_per_second"`
+	StartRequestsPerSecond     int64  `protobuf:"varint,4,opt,name=StartRequestsPerSecond,proto3" json:"StartRequestsPerSecond,omitempty" yaml:"start_requests_per_second"`
+	AmplitudeRequestsPerSecond int64  `protobuf:"varint,5,opt,name=AmplitudeRequestsPerSecond,proto3" json:"AmplitudeRequestsPerSecond,omitempty" yaml:"amplitude_requests_per_second"`
+	PeriodSeconds              int64  `protobuf:"varint,6,opt,name=PeriodSeconds,proto3" json:"PeriodSeconds,omitempty" yaml:"period_seconds"`
+	PeakRequestsPerSecond      int64  `protobuf:"varint,7,opt,name=PeakRequestsPerSecond,proto3" json:"PeakRequestsPerSecond,omitempty" yaml:"peak_requests_per_second"`
+	SpikeSeconds               int64  `protobuf:"varint,8,opt,name=SpikeSeconds,proto3" json:"SpikeSeconds,omitempty" yaml:"spike_seconds"`
+}
+
+func (m *ConfigClientMachineLoadProfileStage) Reset()         { *m = ConfigClientMachineLoadProfileStage{} }
+func (m *ConfigClientMachineLoadProfileStage) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineLoadProfileStage) ProtoMessage()    {}
+func (*ConfigClientMachineLoadProfileStage) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{3}
+}
+
 // ConfigClientMachineBenchmarkSteps represents benchmark steps.
 type ConfigClientMachineBenchmarkSteps struct {
 	Step1StartDatabase  bool `protobuf:"varint,1,opt,name=Step1StartDatabase,proto3" json:"Step1StartDatabase,omitempty" yaml:"step1_start_database"`
@@ -74,20 +268,24 @@ func (m *ConfigClientMachineBenchmarkSteps) Reset()         { *m = ConfigClientM
 func (m *ConfigClientMachineBenchmarkSteps) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineBenchmarkSteps) ProtoMessage()    {}
 func (*ConfigClientMachineBenchmarkSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{2}
+	return fileDescriptorConfigClientMachine, []int{4}
 }
 
 // ConfigClientMachineAgentControl represents control options on client machine.
 type ConfigClientMachineAgentControl struct {
-	DatabaseID                          string                               `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
-	DatabaseDescription                 string                               `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
-	DatabaseTag                         string                               `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
-	PeerIPs                             []string                             `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
-	PeerIPsString                       string                               `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
-	AgentPortToConnect                  int64                                `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
-	AgentEndpoints                      []string                             `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
-	DatabasePortToConnect               int64                                `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
-	DatabaseEndpoints                   []string                             `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	DatabaseID            string   `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
+	DatabaseDescription   string   `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
+	DatabaseTag           string   `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
+	PeerIPs               []string `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
+	PeerIPsString         string   `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
+	AgentPortToConnect    int64    `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
+	AgentEndpoints        []string `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
+	DatabasePortToConnect int64    `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
+	DatabaseEndpoints     []string `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	// LoadGeneratorEndpoints are the agent endpoints of the load generator
+	// machines. If not empty, the requests and clients are split across them,
+	// instead of sent from the control machine, and the results are merged.
+	LoadGeneratorEndpoints              []string                             `protobuf:"bytes,10,rep,name=LoadGeneratorEndpoints" json:"LoadGeneratorEndpoints,omitempty" yaml:"load_generator_endpoints"`
 	Flag_Etcd_Other                     *Flag_Etcd_Other                     `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty" yaml:"etcd__other"`
 	Flag_Etcd_Tip                       *Flag_Etcd_Tip                       `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty" yaml:"etcd__tip"`
 	Flag_Etcd_V3_2                      *Flag_Etcd_V3_2                      `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty" yaml:"etcd__v3_2"`
@@ -96,6 +294,7 @@ type ConfigClientMachineAgentControl struct {
 	Flag_Consul_V1_0_2                  *Flag_Consul_V1_0_2                  `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty" yaml:"consul__v1_0_2"`
 	Flag_Cetcd_Beta                     *Flag_Cetcd_Beta                     `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty" yaml:"cetcd__beta"`
 	Flag_Zetcd_Beta                     *Flag_Zetcd_Beta                     `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty" yaml:"zetcd__beta"`
+	Flag_Ytsaurus_Cypress               *Flag_Ytsaurus_Cypress               `protobuf:"bytes,600,opt,name=flag__ytsaurus__cypress,json=flagYtsaurusCypress" json:"flag__ytsaurus__cypress,omitempty" yaml:"ytsaurus_cypress"`
 	ConfigClientMachineBenchmarkOptions *ConfigClientMachineBenchmarkOptions `protobuf:"bytes,1000,opt,name=ConfigClientMachineBenchmarkOptions" json:"ConfigClientMachineBenchmarkOptions,omitempty" yaml:"benchmark_options"`
 	ConfigClientMachineBenchmarkSteps   *ConfigClientMachineBenchmarkSteps   `protobuf:"bytes,1001,opt,name=ConfigClientMachineBenchmarkSteps" json:"ConfigClientMachineBenchmarkSteps,omitempty" yaml:"benchmark_steps"`
 }
@@ -104,12 +303,14 @@ func (m *ConfigClientMachineAgentControl) Reset()         { *m = ConfigClientMac
 func (m *ConfigClientMachineAgentControl) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineAgentControl) ProtoMessage()    {}
 func (*ConfigClientMachineAgentControl) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{3}
+	return fileDescriptorConfigClientMachine, []int{5}
 }
 
 func init() {
 	proto.RegisterType((*ConfigClientMachineInitial)(nil), "dbtesterpb.ConfigClientMachineInitial")
 	proto.RegisterType((*ConfigClientMachineBenchmarkOptions)(nil), "dbtesterpb.ConfigClientMachineBenchmarkOptions")
+	proto.RegisterType((*ConfigClientMachineSLOSearch)(nil), "dbtesterpb.ConfigClientMachineSLOSearch")
+	proto.RegisterType((*ConfigClientMachineLoadProfileStage)(nil), "dbtesterpb.ConfigClientMachineLoadProfileStage")
 	proto.RegisterType((*ConfigClientMachineBenchmarkSteps)(nil), "dbtesterpb.ConfigClientMachineBenchmarkSteps")
 	proto.RegisterType((*ConfigClientMachineAgentControl)(nil), "dbtesterpb.ConfigClientMachineAgentControl")
 }
@@ -320,6 +521,457 @@ func (m *ConfigClientMachineBenchmarkOptions) MarshalTo(dAtA []byte) (int, error
 		}
 		i++
 	}
+	if m.ReadPercent != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ReadPercent))
+	}
+	if m.KeySpaceSize != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeySpaceSize))
+	}
+	if len(m.RangePrefix) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.RangePrefix)))
+		i += copy(dAtA[i:], m.RangePrefix)
+	}
+	if m.RangeLimit != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RangeLimit))
+	}
+	if m.WatcherNumber != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WatcherNumber))
+	}
+	if m.LeaseTTLSeconds != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.LeaseTTLSeconds))
+	}
+	if m.LeaseKeepAliveSeconds != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.LeaseKeepAliveSeconds))
+	}
+	if len(m.KeyDistribution) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyDistribution)))
+		i += copy(dAtA[i:], m.KeyDistribution)
+	}
+	if m.ZipfianSkew != 0 {
+		dAtA[i] = 0x99
+		i++
+		dAtA[i] = 0x1
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ZipfianSkew))))
+		i += 8
+	}
+	if m.HotspotKeyPercent != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.HotspotKeyPercent))
+	}
+	if m.HotspotRequestPercent != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.HotspotRequestPercent))
+	}
+	if m.RandomSeed != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RandomSeed))
+	}
+	if len(m.ValueSizeDistribution) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ValueSizeDistribution)))
+		i += copy(dAtA[i:], m.ValueSizeDistribution)
+	}
+	if m.ValueSizeMinBytes != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ValueSizeMinBytes))
+	}
+	if m.ValueSizeMaxBytes != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ValueSizeMaxBytes))
+	}
+	if m.ValueSizeStddevBytes != 0 {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ValueSizeStddevBytes))
+	}
+	if len(m.ValueSizeHistogramPath) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ValueSizeHistogramPath)))
+		i += copy(dAtA[i:], m.ValueSizeHistogramPath)
+	}
+	if m.ValuePoolSize != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ValuePoolSize))
+	}
+	if m.ValueCompressibility != 0 {
+		dAtA[i] = 0xe9
+		i++
+		dAtA[i] = 0x1
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ValueCompressibility))))
+		i += 8
+	}
+	if m.DurationSeconds != 0 {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DurationSeconds))
+	}
+	if m.OpenLoop {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.OpenLoop {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.WarmupSeconds != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WarmupSeconds))
+	}
+	if m.WarmupRequests != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WarmupRequests))
+	}
+	if m.CooldownSeconds != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CooldownSeconds))
+	}
+	if m.CooldownRequests != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CooldownRequests))
+	}
+	if m.RequestTimeoutMilliseconds != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RequestTimeoutMilliseconds))
+	}
+	if m.RetryMaxAttempts != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RetryMaxAttempts))
+	}
+	if m.RetryBackoffMilliseconds != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RetryBackoffMilliseconds))
+	}
+	if m.RetryMaxBackoffMilliseconds != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RetryMaxBackoffMilliseconds))
+	}
+	if len(m.RetryErrorCategories) > 0 {
+		for _, s := range m.RetryErrorCategories {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.LoadProfile) > 0 {
+		for _, msg := range m.LoadProfile {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SLOSearch != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SLOSearch.Size()))
+		n3, err := m.SLOSearch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.VerifyWrites {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.VerifyWrites {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.VerifySampleSize != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.VerifySampleSize))
+	}
+	if m.RecordTrace {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.RecordTrace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ReplayTracePath) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ReplayTracePath)))
+		i += copy(dAtA[i:], m.ReplayTracePath)
+	}
+	if m.ReplaySpeedup != 0 {
+		dAtA[i] = 0xf9
+		i++
+		dAtA[i] = 0x2
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReplaySpeedup))))
+		i += 8
+	}
+	if len(m.TxnMode) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.TxnMode)))
+		i += copy(dAtA[i:], m.TxnMode)
+	}
+	if len(m.WatchMode) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.WatchMode)))
+		i += copy(dAtA[i:], m.WatchMode)
+	}
+	if len(m.WatcherNumbers) > 0 {
+		dAtA5 := make([]byte, len(m.WatcherNumbers)*10)
+		var j4 int
+		for _, num1 := range m.WatcherNumbers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineSLOSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineSLOSearch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LatencyPercentile != 0 {
+		dAtA[i] = 0x9
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LatencyPercentile))))
+		i += 8
+	}
+	if m.MaxLatencyMilliseconds != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxLatencyMilliseconds))))
+		i += 8
+	}
+	if m.MaxErrorPercent != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxErrorPercent))))
+		i += 8
+	}
+	if m.MinThroughputPercent != 0 {
+		dAtA[i] = 0x21
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinThroughputPercent))))
+		i += 8
+	}
+	if m.ProbeSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ProbeSeconds))
+	}
+	if m.MinRequestsPerSecond != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MinRequestsPerSecond))
+	}
+	if m.MaxRequestsPerSecond != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxRequestsPerSecond))
+	}
+	if m.ResolutionRequestsPerSecond != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ResolutionRequestsPerSecond))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineLoadProfileStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineLoadProfileStage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.DurationSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DurationSeconds))
+	}
+	if m.RequestsPerSecond != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RequestsPerSecond))
+	}
+	if m.StartRequestsPerSecond != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.StartRequestsPerSecond))
+	}
+	if m.AmplitudeRequestsPerSecond != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.AmplitudeRequestsPerSecond))
+	}
+	if m.PeriodSeconds != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.PeriodSeconds))
+	}
+	if m.PeakRequestsPerSecond != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.PeakRequestsPerSecond))
+	}
+	if m.SpikeSeconds != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SpikeSeconds))
+	}
 	return i, nil
 }
 
@@ -475,29 +1127,44 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.Flag_Etcd_Other != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
-		n3, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
+	if len(m.LoadGeneratorEndpoints) > 0 {
+		for _, s := range m.LoadGeneratorEndpoints {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Flag_Etcd_Other != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
+		n6, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
 	if m.Flag_Etcd_Tip != nil {
 		dAtA[i] = 0xaa
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n4, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		n7, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n7
 	}
 	if m.Flag_Etcd_V3_2 != nil {
 		dAtA[i] = 0xb2
@@ -505,11 +1172,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n5, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		n8, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n8
 	}
 	if m.Flag_Etcd_V3_3 != nil {
 		dAtA[i] = 0xba
@@ -517,11 +1184,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n6, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
+		n9, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n9
 	}
 	if m.Flag_Zookeeper_R3_5_3Beta != nil {
 		dAtA[i] = 0xc2
@@ -529,11 +1196,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n7, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		n10, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n10
 	}
 	if m.Flag_Consul_V1_0_2 != nil {
 		dAtA[i] = 0xe2
@@ -541,11 +1208,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n8, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		n11, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	if m.Flag_Cetcd_Beta != nil {
 		dAtA[i] = 0x82
@@ -553,11 +1220,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x19
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n9, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		n12, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	if m.Flag_Zetcd_Beta != nil {
 		dAtA[i] = 0xa2
@@ -565,11 +1232,23 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1f
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n10, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		n13, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n13
+	}
+	if m.Flag_Ytsaurus_Cypress != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x25
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Ytsaurus_Cypress.Size()))
+		n14, err := m.Flag_Ytsaurus_Cypress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
 	}
 	if m.ConfigClientMachineBenchmarkOptions != nil {
 		dAtA[i] = 0xc2
@@ -577,11 +1256,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
-		n11, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
+		n15, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n15
 	}
 	if m.ConfigClientMachineBenchmarkSteps != nil {
 		dAtA[i] = 0xca
@@ -589,11 +1268,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
-		n12, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
+		n16, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n16
 	}
 	return i, nil
 }
@@ -711,6 +1390,205 @@ func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
 	if m.StaleRead {
 		n += 2
 	}
+	if m.ReadPercent != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ReadPercent))
+	}
+	if m.KeySpaceSize != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.KeySpaceSize))
+	}
+	l = len(m.RangePrefix)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.RangeLimit != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RangeLimit))
+	}
+	if m.WatcherNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.WatcherNumber))
+	}
+	if m.LeaseTTLSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.LeaseTTLSeconds))
+	}
+	if m.LeaseKeepAliveSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.LeaseKeepAliveSeconds))
+	}
+	l = len(m.KeyDistribution)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ZipfianSkew != 0 {
+		n += 10
+	}
+	if m.HotspotKeyPercent != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.HotspotKeyPercent))
+	}
+	if m.HotspotRequestPercent != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.HotspotRequestPercent))
+	}
+	if m.RandomSeed != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.RandomSeed))
+	}
+	l = len(m.ValueSizeDistribution)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ValueSizeMinBytes != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ValueSizeMinBytes))
+	}
+	if m.ValueSizeMaxBytes != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ValueSizeMaxBytes))
+	}
+	if m.ValueSizeStddevBytes != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ValueSizeStddevBytes))
+	}
+	l = len(m.ValueSizeHistogramPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ValuePoolSize != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ValuePoolSize))
+	}
+	if m.ValueCompressibility != 0 {
+		n += 10
+	}
+	if m.DurationSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.DurationSeconds))
+	}
+	if m.OpenLoop {
+		n += 3
+	}
+	if m.WarmupSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.WarmupSeconds))
+	}
+	if m.WarmupRequests != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.WarmupRequests))
+	}
+	if m.CooldownSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.CooldownSeconds))
+	}
+	if m.CooldownRequests != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.CooldownRequests))
+	}
+	if m.RequestTimeoutMilliseconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.RequestTimeoutMilliseconds))
+	}
+	if m.RetryMaxAttempts != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.RetryMaxAttempts))
+	}
+	if m.RetryBackoffMilliseconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.RetryBackoffMilliseconds))
+	}
+	if m.RetryMaxBackoffMilliseconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.RetryMaxBackoffMilliseconds))
+	}
+	if len(m.RetryErrorCategories) > 0 {
+		for _, s := range m.RetryErrorCategories {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.LoadProfile) > 0 {
+		for _, e := range m.LoadProfile {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.SLOSearch != nil {
+		l = m.SLOSearch.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.VerifyWrites {
+		n += 3
+	}
+	if m.VerifySampleSize != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.VerifySampleSize))
+	}
+	if m.RecordTrace {
+		n += 3
+	}
+	l = len(m.ReplayTracePath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ReplaySpeedup != 0 {
+		n += 10
+	}
+	l = len(m.TxnMode)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.WatchMode)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.WatcherNumbers) > 0 {
+		l = 0
+		for _, e := range m.WatcherNumbers {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 2 + sovConfigClientMachine(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ConfigClientMachineSLOSearch) Size() (n int) {
+	var l int
+	_ = l
+	if m.LatencyPercentile != 0 {
+		n += 9
+	}
+	if m.MaxLatencyMilliseconds != 0 {
+		n += 9
+	}
+	if m.MaxErrorPercent != 0 {
+		n += 9
+	}
+	if m.MinThroughputPercent != 0 {
+		n += 9
+	}
+	if m.ProbeSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ProbeSeconds))
+	}
+	if m.MinRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MinRequestsPerSecond))
+	}
+	if m.MaxRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxRequestsPerSecond))
+	}
+	if m.ResolutionRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ResolutionRequestsPerSecond))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineLoadProfileStage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DurationSeconds))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RequestsPerSecond))
+	}
+	if m.StartRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.StartRequestsPerSecond))
+	}
+	if m.AmplitudeRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.AmplitudeRequestsPerSecond))
+	}
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.PeriodSeconds))
+	}
+	if m.PeakRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.PeakRequestsPerSecond))
+	}
+	if m.SpikeSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.SpikeSeconds))
+	}
 	return n
 }
 
@@ -775,6 +1653,12 @@ func (m *ConfigClientMachineAgentControl) Size() (n int) {
 			n += 1 + l + sovConfigClientMachine(uint64(l))
 		}
 	}
+	if len(m.LoadGeneratorEndpoints) > 0 {
+		for _, s := range m.LoadGeneratorEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
 	if m.Flag_Etcd_Other != nil {
 		l = m.Flag_Etcd_Other.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
@@ -807,6 +1691,10 @@ func (m *ConfigClientMachineAgentControl) Size() (n int) {
 		l = m.Flag_Zetcd_Beta.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
 	}
+	if m.Flag_Ytsaurus_Cypress != nil {
+		l = m.Flag_Ytsaurus_Cypress.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
 	if m.ConfigClientMachineBenchmarkOptions != nil {
 		l = m.ConfigClientMachineBenchmarkOptions.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
@@ -1169,21 +2057,1414 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			}
+			m.RequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			}
+			m.ClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+			}
+			m.RateLimitRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameKey = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+			}
+			m.KeySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+			}
+			m.ValueSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleRead = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPercent", wireType)
+			}
+			m.ReadPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadPercent |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySpaceSize", wireType)
+			}
+			m.KeySpaceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeySpaceSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeLimit", wireType)
+			}
+			m.RangeLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherNumber", wireType)
+			}
+			m.WatcherNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatcherNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseTTLSeconds", wireType)
+			}
+			m.LeaseTTLSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseTTLSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseKeepAliveSeconds", wireType)
+			}
+			m.LeaseKeepAliveSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseKeepAliveSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyDistribution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyDistribution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZipfianSkew", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ZipfianSkew = float64(math.Float64frombits(v))
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotspotKeyPercent", wireType)
+			}
+			m.HotspotKeyPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HotspotKeyPercent |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotspotRequestPercent", wireType)
+			}
+			m.HotspotRequestPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HotspotRequestPercent |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomSeed", wireType)
+			}
+			m.RandomSeed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandomSeed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeDistribution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueSizeDistribution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeMinBytes", wireType)
+			}
+			m.ValueSizeMinBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeMinBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeMaxBytes", wireType)
+			}
+			m.ValueSizeMaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeMaxBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeStddevBytes", wireType)
+			}
+			m.ValueSizeStddevBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeStddevBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeHistogramPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueSizeHistogramPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuePoolSize", wireType)
+			}
+			m.ValuePoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValuePoolSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueCompressibility", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ValueCompressibility = float64(math.Float64frombits(v))
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenLoop", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OpenLoop = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarmupSeconds", wireType)
+			}
+			m.WarmupSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarmupSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarmupRequests", wireType)
+			}
+			m.WarmupRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarmupRequests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownSeconds", wireType)
+			}
+			m.CooldownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownRequests", wireType)
+			}
+			m.CooldownRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownRequests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeoutMilliseconds", wireType)
+			}
+			m.RequestTimeoutMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimeoutMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaxAttempts", wireType)
+			}
+			m.RetryMaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryMaxAttempts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffMilliseconds", wireType)
+			}
+			m.RetryBackoffMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoffMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaxBackoffMilliseconds", wireType)
+			}
+			m.RetryMaxBackoffMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryMaxBackoffMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryErrorCategories", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryErrorCategories = append(m.RetryErrorCategories, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoadProfile = append(m.LoadProfile, &ConfigClientMachineLoadProfileStage{})
+			if err := m.LoadProfile[len(m.LoadProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SLOSearch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SLOSearch == nil {
+				m.SLOSearch = &ConfigClientMachineSLOSearch{}
+			}
+			if err := m.SLOSearch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyWrites", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyWrites = bool(v != 0)
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifySampleSize", wireType)
+			}
+			m.VerifySampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifySampleSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTrace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordTrace = bool(v != 0)
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayTracePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplayTracePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 47:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaySpeedup", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReplaySpeedup = float64(math.Float64frombits(v))
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxnMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WatchMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 50:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WatcherNumbers = append(m.WatcherNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WatcherNumbers = append(m.WatcherNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherNumbers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineSLOSearch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineSLOSearch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineSLOSearch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LatencyPercentile = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatencyMilliseconds", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxLatencyMilliseconds = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErrorPercent", wireType)
 			}
-			var stringLen uint64
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxErrorPercent = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinThroughputPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinThroughputPercent = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeSeconds", wireType)
+			}
+			m.ProbeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1193,26 +3474,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ProbeSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRequestsPerSecond", wireType)
 			}
-			var stringLen uint64
+			m.MinRequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1222,26 +3493,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MinRequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestsPerSecond", wireType)
 			}
-			var stringLen uint64
+			m.MaxRequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1251,26 +3512,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxRequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 104:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionRequestsPerSecond", wireType)
 			}
-			var stringLen uint64
+			m.ResolutionRequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1280,21 +3531,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ResolutionRequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -1316,7 +3557,7 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+func (m *ConfigClientMachineLoadProfileStage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,10 +3580,10 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigClientMachineLoadProfileStage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigClientMachineLoadProfileStage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1376,9 +3617,9 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
 			}
-			m.RequestNumber = 0
+			m.DurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1388,16 +3629,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				m.DurationSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
 			}
-			m.ConnectionNumber = 0
+			m.RequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1407,16 +3648,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				m.RequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRequestsPerSecond", wireType)
 			}
-			m.ClientNumber = 0
+			m.StartRequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1426,97 +3667,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				m.StartRequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowConfigClientMachine
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowConfigClientMachine
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthConfigClientMachine
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowConfigClientMachine
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
-			}
-			m.RateLimitRequestsPerSecond = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfigClientMachine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplitudeRequestsPerSecond", wireType)
 			}
-			var v int
+			m.AmplitudeRequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1526,17 +3686,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.AmplitudeRequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SameKey = bool(v != 0)
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
 			}
-			m.KeySizeBytes = 0
+			m.PeriodSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1546,16 +3705,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				m.PeriodSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakRequestsPerSecond", wireType)
 			}
-			m.ValueSizeBytes = 0
+			m.PeakRequestsPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1565,16 +3724,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				m.PeakRequestsPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpikeSeconds", wireType)
 			}
-			var v int
+			m.SpikeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1584,12 +3743,11 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.SpikeSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StaleRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -2011,6 +4169,35 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			}
 			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadGeneratorEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoadGeneratorEndpoints = append(m.LoadGeneratorEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Other", wireType)
@@ -2275,6 +4462,39 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 600:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Ytsaurus_Cypress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Ytsaurus_Cypress == nil {
+				m.Flag_Ytsaurus_Cypress = &Flag_Ytsaurus_Cypress{}
+			}
+			if err := m.Flag_Ytsaurus_Cypress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1000:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkOptions", wireType)
@@ -2472,112 +4692,195 @@ func init() {
 }
 
 var fileDescriptorConfigClientMachine = []byte{
-	// 1711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcd, 0x6e, 0xdb, 0xc8,
-	0x1d, 0x5f, 0x45, 0xc9, 0xc6, 0x1e, 0xe7, 0x73, 0x12, 0x27, 0x8a, 0xe3, 0x78, 0x1c, 0x26, 0xe9,
-	0x7a, 0xb1, 0x8d, 0x9d, 0x48, 0xd9, 0x05, 0x5a, 0xb4, 0x68, 0x57, 0xf6, 0xb6, 0x0d, 0xe2, 0xdd,
-	0xa8, 0x94, 0x37, 0x45, 0x83, 0xa2, 0xd3, 0x11, 0x35, 0xa6, 0xb8, 0xa6, 0x38, 0x2c, 0x67, 0x18,
-	0x54, 0xee, 0xad, 0x28, 0x50, 0xb4, 0xa7, 0x3d, 0xee, 0xb1, 0x0f, 0xd0, 0xe7, 0x28, 0x72, 0xec,
-	0x13, 0x10, 0x6d, 0xf6, 0xd2, 0x5e, 0x89, 0x3e, 0xc0, 0x62, 0xfe, 0x24, 0xa5, 0xa1, 0x44, 0xc9,
-	0xba, 0x89, 0xf3, 0xff, 0x7d, 0xcd, 0x70, 0xbe, 0x28, 0xf4, 0xbd, 0x7e, 0x4f, 0x71, 0xa9, 0x78,
-	0x14, 0xf6, 0xf6, 0x1c, 0x11, 0x1c, 0x7b, 0x2e, 0x75, 0x7c, 0x8f, 0x07, 0x8a, 0x0e, 0x99, 0x33,
-	0xf0, 0x02, 0xbe, 0x1b, 0x46, 0x42, 0x09, 0x8c, 0x26, 0xb8, 0x8d, 0xc7, 0xae, 0xa7, 0x06, 0x71,
-	0x6f, 0xd7, 0x11, 0xc3, 0x3d, 0x57, 0xb8, 0x62, 0x0f, 0x20, 0xbd, 0xf8, 0x18, 0x9e, 0xe0, 0x01,
-	0x7e, 0x65, 0xd4, 0x8d, 0x0d, 0xc3, 0xe2, 0xd8, 0x67, 0x2e, 0xe5, 0xca, 0xe9, 0xe7, 0x35, 0x32,
-	0x5d, 0x3b, 0x15, 0xe2, 0x84, 0xf3, 0x90, 0x47, 0x39, 0x60, 0x73, 0x1a, 0xe0, 0x88, 0x40, 0xc6,
-	0x7e, 0x5e, 0xbd, 0x3b, 0x43, 0x37, 0xb4, 0x67, 0x8a, 0xce, 0xa4, 0x68, 0x7d, 0x7b, 0x09, 0x6d,
-	0xec, 0x43, 0x7f, 0xf7, 0xa1, 0xbb, 0x9f, 0x67, 0xbd, 0x7d, 0x1e, 0x78, 0xca, 0x63, 0x3e, 0xfe,
-	0x04, 0xa1, 0x0e, 0x53, 0x83, 0x4e, 0xc4, 0x8f, 0xbd, 0x3f, 0x34, 0x6a, 0xdb, 0xb5, 0x9d, 0xd5,
-	0xf6, 0xad, 0x34, 0x21, 0x78, 0xc4, 0x86, 0xfe, 0x0f, 0xad, 0x90, 0xa9, 0x01, 0x0d, 0xa1, 0x68,
-	0xd9, 0x06, 0x12, 0x3f, 0x46, 0x17, 0x0f, 0x85, 0xab, 0x1b, 0x1a, 0xe7, 0x80, 0x74, 0x23, 0x4d,
-	0xc8, 0xd5, 0x8c, 0xe4, 0x0b, 0x97, 0x6a, 0xa2, 0x65, 0x17, 0x18, 0x4c, 0xd1, 0xed, 0xcc, 0xbe,
-	0x3b, 0x92, 0x8a, 0x0f, 0x3f, 0xe7, 0x2a, 0xf2, 0x1c, 0x09, 0xf4, 0x3a, 0xd0, 0x1f, 0xa5, 0x09,
-	0xb9, 0x9f, 0xd1, 0xf3, 0xd7, 0x22, 0x01, 0x49, 0x87, 0x19, 0x34, 0x17, 0x9c, 0xa7, 0x82, 0xff,
-	0x5c, 0x43, 0x0f, 0x2a, 0x6a, 0xcf, 0x03, 0x3d, 0x2c, 0xc2, 0x67, 0x8a, 0xf7, 0xc1, 0xed, 0x3c,
-	0xb8, 0x35, 0xd3, 0x84, 0xec, 0x2e, 0x72, 0xf3, 0x0c, 0x5e, 0x6e, 0xbd, 0x8c, 0x3c, 0xfe, 0x5b,
-	0x0d, 0x3d, 0xca, 0x70, 0x87, 0x4c, 0xf1, 0xc0, 0x19, 0x1d, 0x0d, 0x22, 0x11, 0xbb, 0x83, 0x30,
-	0x56, 0x47, 0xde, 0x90, 0x4b, 0x1e, 0x79, 0x3c, 0xeb, 0xf6, 0x05, 0x08, 0xf2, 0x2c, 0x4d, 0xc8,
-	0x93, 0x52, 0x10, 0x3f, 0xe3, 0x51, 0x35, 0x26, 0x52, 0x35, 0x66, 0xe6, 0x51, 0x96, 0xb3, 0xc0,
-	0x7f, 0x44, 0xdb, 0x25, 0xe0, 0x81, 0x27, 0x55, 0xe4, 0xf5, 0x62, 0xe5, 0x89, 0xe0, 0x53, 0xdf,
-	0x87, 0x18, 0xef, 0x43, 0x8c, 0xbd, 0x34, 0x21, 0x1f, 0x55, 0xc6, 0xe8, 0x1b, 0x1c, 0xca, 0x7c,
-	0x3f, 0x4f, 0x70, 0xa6, 0x30, 0xfe, 0xba, 0x86, 0x3e, 0x98, 0x0b, 0xea, 0xf0, 0xc8, 0xe1, 0x81,
-	0xf2, 0x7c, 0x0e, 0x21, 0x2e, 0x42, 0x88, 0x4f, 0xd2, 0x84, 0x34, 0xcf, 0x0e, 0x11, 0x8e, 0xb9,
-	0x79, 0x96, 0x65, 0x6d, 0xf0, 0x5f, 0x6a, 0xe8, 0xe1, 0x5c, 0x6c, 0x37, 0x1e, 0x0e, 0x59, 0x34,
-	0x82, 0x3c, 0x2b, 0x90, 0xa7, 0x95, 0x26, 0x64, 0xef, 0xec, 0x3c, 0x32, 0x23, 0xe6, 0x61, 0x96,
-	0x32, 0xc0, 0x21, 0xda, 0x2c, 0xe1, 0xda, 0xa3, 0x17, 0x7c, 0xf4, 0x45, 0x3c, 0xec, 0xf1, 0x08,
-	0x02, 0xac, 0x42, 0x80, 0xef, 0xa7, 0x09, 0xd9, 0xa9, 0x0c, 0xd0, 0x1b, 0xd1, 0x13, 0x3e, 0xa2,
-	0x01, 0x30, 0x72, 0xe7, 0x85, 0x8a, 0x78, 0x84, 0x48, 0x97, 0x47, 0x6f, 0x78, 0x74, 0xe0, 0xc9,
-	0x93, 0x6e, 0xc8, 0x1c, 0xfe, 0xa5, 0x64, 0x2e, 0x37, 0x7b, 0x8d, 0xa6, 0xa7, 0x82, 0x04, 0x82,
-	0xee, 0xed, 0x09, 0x95, 0x9a, 0x42, 0x63, 0xcd, 0x99, 0xea, 0xf1, 0x59, 0xba, 0xf8, 0x37, 0xe8,
-	0xd6, 0xcf, 0x85, 0x70, 0x7d, 0xbe, 0xef, 0x8b, 0xb8, 0xdf, 0x89, 0xc4, 0x57, 0xdc, 0x51, 0x5f,
-	0xb0, 0x21, 0x6f, 0xf4, 0xc1, 0xf1, 0x61, 0x9a, 0x90, 0xed, 0xcc, 0xd1, 0x05, 0x1c, 0x75, 0x34,
-	0x90, 0x86, 0x19, 0x92, 0x06, 0x6c, 0xc8, 0x2d, 0x7b, 0x8e, 0x06, 0x3e, 0x46, 0x77, 0x8c, 0x4a,
-	0x57, 0x89, 0x88, 0xb9, 0xfc, 0x05, 0xcf, 0xba, 0xc4, 0xc1, 0x60, 0x27, 0x4d, 0xc8, 0xc3, 0x0a,
-	0x03, 0x99, 0x81, 0x61, 0x28, 0xb3, 0xbe, 0xcc, 0x97, 0xc2, 0xcf, 0xd0, 0x7a, 0x65, 0xb1, 0x71,
-	0xac, 0x3d, 0xec, 0xea, 0x22, 0x16, 0x68, 0x73, 0xb6, 0xd0, 0x8e, 0x9d, 0x13, 0x9e, 0x8d, 0x80,
-	0x0b, 0x01, 0x3f, 0x4a, 0x13, 0xf2, 0xc1, 0x82, 0x80, 0x3d, 0x20, 0xe4, 0x03, 0xb1, 0x50, 0x10,
-	0xc7, 0x68, 0x6b, 0xb6, 0xde, 0x8d, 0x7b, 0x07, 0x5e, 0xc4, 0x1d, 0x25, 0xa2, 0x51, 0x63, 0x00,
-	0x96, 0x8f, 0xd3, 0x84, 0x7c, 0xb8, 0xc0, 0x52, 0xc6, 0x3d, 0xda, 0x2f, 0x38, 0x96, 0x7d, 0x86,
-	0xa8, 0xf5, 0xcf, 0x0b, 0xe8, 0x41, 0xc5, 0x29, 0xd3, 0xe6, 0x81, 0x33, 0x18, 0xb2, 0xe8, 0xe4,
-	0x65, 0xa8, 0x97, 0x80, 0xc4, 0x0f, 0xd0, 0xf9, 0xa3, 0x51, 0xc8, 0xf3, 0x83, 0xe6, 0x6a, 0x9a,
-	0x90, 0xb5, 0x2c, 0x84, 0x1a, 0x85, 0xdc, 0xb2, 0xa1, 0x88, 0x7f, 0x82, 0x2e, 0xdb, 0xfc, 0xf7,
-	0x31, 0x97, 0x2a, 0x9b, 0xc0, 0x70, 0xc2, 0xd4, 0xdb, 0x77, 0xd2, 0x84, 0xac, 0x67, 0xe8, 0x28,
-	0x2b, 0xe7, 0x0b, 0xc0, 0xb2, 0xcb, 0x78, 0xfc, 0x0b, 0x74, 0x6d, 0x5f, 0x04, 0x01, 0x77, 0xb4,
-	0x69, 0xae, 0x51, 0x07, 0x8d, 0xcd, 0x34, 0x21, 0x8d, 0x7c, 0x49, 0x8d, 0x11, 0x63, 0x99, 0x19,
-	0x16, 0xfe, 0x11, 0xba, 0x94, 0x75, 0x28, 0x57, 0x39, 0x0f, 0x2a, 0x8d, 0x34, 0x21, 0x37, 0x4b,
-	0x0b, 0xb3, 0x50, 0x28, 0xa1, 0xf1, 0x6f, 0xd1, 0xed, 0x89, 0xa2, 0x59, 0x91, 0x8d, 0x0b, 0xdb,
-	0xf5, 0x9d, 0xba, 0x39, 0xf5, 0x8d, 0x38, 0x25, 0x4d, 0xa9, 0x0f, 0xbd, 0x6a, 0x11, 0xec, 0xa1,
-	0x0d, 0x9b, 0x29, 0x7e, 0xe8, 0x0d, 0x3d, 0x95, 0x8f, 0x80, 0xec, 0xf0, 0xa8, 0xcb, 0x1d, 0x11,
-	0xf4, 0x61, 0x6b, 0xaf, 0xb7, 0x3f, 0x4c, 0x13, 0xf2, 0x28, 0x1f, 0x35, 0xa6, 0x38, 0xf5, 0x35,
-	0x98, 0xe6, 0x03, 0x28, 0xf5, 0x6e, 0x4a, 0x25, 0xe0, 0x2d, 0x7b, 0x81, 0x98, 0x3e, 0xef, 0xbb,
-	0x6c, 0x08, 0x13, 0x5e, 0xef, 0xd6, 0x2b, 0xe6, 0x79, 0x2f, 0xd9, 0x10, 0x16, 0x91, 0x65, 0x17,
-	0x18, 0xfc, 0x63, 0x74, 0xe9, 0x05, 0x1f, 0x75, 0xbd, 0x53, 0xde, 0x1e, 0x29, 0x2e, 0x61, 0x47,
-	0x2d, 0xbd, 0x41, 0xbd, 0xe6, 0xa4, 0x77, 0xca, 0x69, 0x4f, 0xd7, 0x2d, 0xbb, 0x04, 0xc7, 0xfb,
-	0xe8, 0xca, 0x2b, 0xe6, 0xc7, 0x7c, 0x22, 0xb0, 0x0a, 0x02, 0x77, 0xd3, 0x84, 0xdc, 0xce, 0x04,
-	0xde, 0xe8, 0x7a, 0x49, 0x62, 0x8a, 0x82, 0x5b, 0x68, 0xb5, 0xab, 0x98, 0xcf, 0x6d, 0xce, 0xfa,
-	0xb0, 0xb9, 0xad, 0xb4, 0xd7, 0xd3, 0x84, 0x5c, 0xcf, 0x43, 0xeb, 0x12, 0x8d, 0x38, 0xeb, 0x5b,
-	0xf6, 0x04, 0x67, 0x25, 0xe7, 0xd0, 0xfd, 0x45, 0x13, 0xb9, 0xab, 0x78, 0x28, 0xf1, 0x4b, 0x84,
-	0xf5, 0x8f, 0xa7, 0x5d, 0xc5, 0x22, 0x75, 0xc0, 0x14, 0xeb, 0x31, 0x99, 0x4d, 0xea, 0x95, 0x36,
-	0x49, 0x13, 0x72, 0xb7, 0xf0, 0xe0, 0xe1, 0x53, 0x2a, 0x35, 0x88, 0xf6, 0x73, 0x94, 0x65, 0x57,
-	0x50, 0xb1, 0x8d, 0x6e, 0xe8, 0xd6, 0x66, 0x57, 0x45, 0x5c, 0xca, 0xb1, 0xe2, 0x39, 0x50, 0xdc,
-	0x4e, 0x13, 0xb2, 0x39, 0x51, 0x6c, 0x52, 0x09, 0x28, 0x43, 0xb2, 0x8a, 0x8c, 0x0f, 0xd1, 0x75,
-	0xdd, 0xdc, 0xea, 0x2a, 0x11, 0x8e, 0x15, 0xeb, 0xa0, 0xb8, 0x95, 0x26, 0x64, 0x63, 0xa2, 0xd8,
-	0xd2, 0xcb, 0x3e, 0x34, 0xf4, 0x66, 0x89, 0xf8, 0x67, 0xe8, 0xaa, 0x6e, 0x7c, 0xf6, 0x65, 0xe8,
-	0x0b, 0xd6, 0x3f, 0x14, 0xae, 0x84, 0xc5, 0xb0, 0x62, 0x2e, 0x29, 0xad, 0xf5, 0x8c, 0xc6, 0x80,
-	0xa0, 0xbe, 0x70, 0xa5, 0x65, 0x4f, 0x93, 0xac, 0x3f, 0x5d, 0x41, 0xa4, 0x62, 0x80, 0x3f, 0x75,
-	0x79, 0xa0, 0xf6, 0x45, 0xa0, 0x22, 0x01, 0x97, 0xd2, 0xc2, 0xf7, 0xf9, 0xc1, 0xec, 0xa5, 0xb4,
-	0xc8, 0x49, 0xbd, 0xbe, 0x65, 0x1b, 0x48, 0xfc, 0x4b, 0x74, 0xa3, 0x78, 0x3a, 0xe0, 0xd2, 0x89,
-	0x3c, 0xd8, 0x75, 0xf2, 0x0b, 0xaa, 0xf1, 0x5e, 0xc6, 0x02, 0xfd, 0x09, 0xca, 0xb2, 0xab, 0xb8,
-	0xf8, 0x07, 0x68, 0xad, 0x68, 0x3e, 0x62, 0x6e, 0x7e, 0x59, 0xbd, 0x9d, 0x26, 0xe4, 0xc6, 0x94,
-	0x94, 0x62, 0xae, 0x65, 0x9b, 0x58, 0xbd, 0x64, 0x3a, 0x9c, 0x47, 0xcf, 0x3b, 0x7a, 0xa4, 0xea,
-	0xe5, 0x2b, 0x72, 0xc8, 0x79, 0x44, 0xbd, 0x50, 0x5a, 0x76, 0x81, 0xc1, 0x3f, 0x45, 0x97, 0xf3,
-	0x9f, 0x5d, 0x15, 0x79, 0x81, 0x9b, 0xdf, 0x10, 0x37, 0xd2, 0x84, 0xdc, 0x2a, 0x93, 0xf4, 0xfb,
-	0xf7, 0x02, 0xd7, 0xb2, 0xcb, 0x04, 0xdc, 0x41, 0x18, 0x86, 0xb1, 0x23, 0x22, 0x75, 0x24, 0xf2,
-	0x4d, 0x23, 0xdf, 0x06, 0x8c, 0x39, 0xc4, 0x34, 0x86, 0x86, 0x22, 0x52, 0x54, 0x09, 0x9a, 0xef,
-	0x3b, 0x96, 0x5d, 0xc1, 0xc5, 0x6d, 0x74, 0x05, 0x5a, 0x3f, 0x0b, 0xfa, 0xa1, 0xf0, 0x02, 0x25,
-	0x1b, 0x17, 0xa1, 0x27, 0x46, 0xa8, 0x4c, 0x8d, 0x17, 0x00, 0xcb, 0x9e, 0x62, 0xe0, 0x5f, 0xa3,
-	0xf5, 0x62, 0x54, 0xca, 0xc1, 0xb2, 0x3d, 0xe1, 0x41, 0x9a, 0x10, 0x32, 0x35, 0x96, 0x33, 0xd9,
-	0xaa, 0x15, 0xf0, 0x0b, 0x74, 0xbd, 0x28, 0x4c, 0x12, 0xae, 0x42, 0xc2, 0x7b, 0x69, 0x42, 0xee,
-	0x4c, 0xc9, 0x1a, 0x21, 0x67, 0x79, 0x98, 0xa2, 0xeb, 0xf0, 0xf1, 0x04, 0x5f, 0x6d, 0x94, 0x0a,
-	0x35, 0xe0, 0x11, 0xdc, 0x50, 0xd6, 0x9a, 0xf7, 0x76, 0x27, 0x5f, 0x58, 0xbb, 0x33, 0x20, 0x73,
-	0x6a, 0x1a, 0xcd, 0x96, 0x7d, 0x59, 0x43, 0x3f, 0x53, 0x4e, 0xff, 0xa5, 0x7e, 0xc6, 0xbf, 0x42,
-	0x57, 0x4d, 0xae, 0xf2, 0x42, 0xb8, 0x9f, 0xac, 0x35, 0xef, 0xce, 0x93, 0x57, 0x5e, 0xd8, 0xbe,
-	0x99, 0x26, 0xe4, 0x9a, 0x29, 0xae, 0xbc, 0xd0, 0xb2, 0xd7, 0x0a, 0xe9, 0x23, 0x2f, 0xc4, 0xaf,
-	0xd1, 0x35, 0x93, 0xf5, 0xa6, 0x45, 0x9b, 0x70, 0x2b, 0x59, 0x6b, 0x6e, 0xce, 0x53, 0xd6, 0x18,
-	0x73, 0x37, 0x9c, 0xb4, 0x1a, 0xda, 0xaf, 0x5a, 0xcd, 0x0a, 0xed, 0x16, 0x5c, 0x5a, 0x16, 0x6b,
-	0xb7, 0x2a, 0xb5, 0x5b, 0x25, 0xed, 0x16, 0xfe, 0x6b, 0x0d, 0x6d, 0x66, 0xc4, 0xf1, 0xc7, 0x30,
-	0xa5, 0x51, 0x8b, 0x7e, 0x4c, 0x5b, 0xb4, 0xc7, 0x15, 0x6b, 0xbc, 0xad, 0x81, 0xd3, 0xce, 0xac,
-	0x53, 0x35, 0xa1, 0x7d, 0x3f, 0x4d, 0xc8, 0xbd, 0xcc, 0xb5, 0x1a, 0x61, 0xd9, 0xeb, 0x5a, 0xe0,
-	0x75, 0x51, 0xb4, 0x5b, 0x1f, 0xb7, 0xda, 0x5c, 0x31, 0xfc, 0x15, 0xba, 0x99, 0x29, 0x67, 0x9f,
-	0xdd, 0x94, 0xbe, 0x79, 0x4a, 0x9f, 0xd0, 0x66, 0xe3, 0x1f, 0xe7, 0x20, 0xc2, 0xf6, 0x6c, 0x84,
-	0x32, 0xd0, 0x3c, 0xdb, 0xca, 0x15, 0xcb, 0xbe, 0xa2, 0x09, 0xfb, 0xd0, 0xf8, 0xea, 0xe9, 0x93,
-	0x26, 0xfe, 0x5d, 0x31, 0xd3, 0x9c, 0x6c, 0x68, 0xa0, 0xaf, 0x5f, 0xd7, 0xe7, 0x4d, 0x35, 0x03,
-	0x65, 0x4e, 0x35, 0xa3, 0x39, 0x9f, 0x6a, 0xfb, 0xba, 0x05, 0x7a, 0x33, 0x76, 0x38, 0x35, 0x1c,
-	0xfe, 0x3f, 0xd7, 0xe1, 0xb4, 0xda, 0xe1, 0x74, 0xc6, 0xe1, 0xf5, 0xd8, 0xe1, 0xef, 0xb5, 0xa5,
-	0x2e, 0x7c, 0x8d, 0xff, 0x5e, 0x04, 0xd3, 0x3d, 0xd3, 0x74, 0x09, 0x9e, 0x79, 0xaa, 0xf4, 0x8a,
-	0x1a, 0x15, 0x59, 0x51, 0x7f, 0x8b, 0x2f, 0x71, 0xd7, 0xfc, 0xa6, 0xb6, 0xc4, 0x51, 0xde, 0xf8,
-	0x5f, 0x16, 0xf0, 0xf1, 0xb2, 0x01, 0x81, 0x65, 0x6e, 0x80, 0x93, 0x78, 0xfa, 0xf8, 0x93, 0x96,
-	0x7d, 0xb6, 0x69, 0xfb, 0xe6, 0xdb, 0xff, 0x6c, 0xbd, 0xf7, 0xf6, 0xdd, 0x56, 0xed, 0x5f, 0xef,
-	0xb6, 0x6a, 0xff, 0x7e, 0xb7, 0x55, 0xfb, 0xe6, 0xdb, 0xad, 0xf7, 0x7a, 0xef, 0xc3, 0x3f, 0x36,
-	0xad, 0xef, 0x02, 0x00, 0x00, 0xff, 0xff, 0x25, 0x94, 0x69, 0xa1, 0xab, 0x12, 0x00, 0x00,
+	// 3039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0xcd, 0x73, 0xdc, 0xb6,
+	0x15, 0xcf, 0x5a, 0x76, 0x2c, 0x43, 0xfe, 0x84, 0xbf, 0x68, 0x59, 0x16, 0x65, 0xda, 0x8e, 0x95,
+	0x26, 0xfe, 0xd2, 0x3a, 0x99, 0x69, 0xa7, 0x9d, 0xd6, 0x92, 0xdd, 0xc4, 0x63, 0x29, 0x56, 0xb9,
+	0x8a, 0xd3, 0xb8, 0x1f, 0x28, 0x96, 0x84, 0x56, 0x8c, 0xb8, 0x04, 0x4b, 0x62, 0x6d, 0xad, 0x7b,
+	0xea, 0x4c, 0x67, 0x3a, 0xed, 0x29, 0xc7, 0x1c, 0xfb, 0x07, 0xf4, 0xaf, 0xe8, 0x29, 0xc7, 0x1e,
+	0x73, 0xe2, 0xb4, 0xc9, 0xa5, 0xb9, 0x72, 0xfa, 0x07, 0x74, 0xf0, 0x00, 0x72, 0xc1, 0x8f, 0x95,
+	0x34, 0x3d, 0x49, 0xc4, 0xfb, 0xfd, 0x7e, 0xef, 0x01, 0x04, 0x1e, 0x1e, 0xc0, 0x45, 0xef, 0xf8,
+	0x7d, 0xc1, 0x52, 0xc1, 0x92, 0xb8, 0x7f, 0xcf, 0xe3, 0xd1, 0x76, 0x30, 0x20, 0x5e, 0x18, 0xb0,
+	0x48, 0x90, 0x21, 0xf5, 0x76, 0x82, 0x88, 0xdd, 0x8d, 0x13, 0x2e, 0x38, 0x46, 0x13, 0xdc, 0xfc,
+	0x9d, 0x41, 0x20, 0x76, 0x46, 0xfd, 0xbb, 0x1e, 0x1f, 0xde, 0x1b, 0xf0, 0x01, 0xbf, 0x07, 0x90,
+	0xfe, 0x68, 0x1b, 0x9e, 0xe0, 0x01, 0xfe, 0x53, 0xd4, 0xf9, 0x79, 0xc3, 0xc5, 0x76, 0x48, 0x07,
+	0x84, 0x09, 0xcf, 0xd7, 0x36, 0xbb, 0x6e, 0x7b, 0xc3, 0xf9, 0x2e, 0x63, 0x31, 0x4b, 0x34, 0x60,
+	0xa1, 0x0e, 0xf0, 0x78, 0x94, 0x8e, 0x42, 0x6d, 0xbd, 0xda, 0xa0, 0x1b, 0xda, 0x0d, 0xa3, 0x67,
+	0x18, 0x17, 0xeb, 0xc6, 0xb1, 0x48, 0xe9, 0x28, 0x19, 0xa5, 0xca, 0xee, 0x7c, 0x77, 0x12, 0xcd,
+	0xaf, 0xc1, 0x78, 0xac, 0xc1, 0x70, 0x6c, 0xa8, 0xd1, 0x78, 0x1a, 0x05, 0x22, 0xa0, 0x21, 0xfe,
+	0x10, 0xa1, 0x4d, 0x2a, 0x76, 0x36, 0x13, 0xb6, 0x1d, 0xec, 0x59, 0x9d, 0xa5, 0xce, 0xf2, 0x89,
+	0xd5, 0x4b, 0x79, 0x66, 0xe3, 0x31, 0x1d, 0x86, 0x3f, 0x72, 0x62, 0x2a, 0x76, 0x48, 0x0c, 0x46,
+	0xc7, 0x35, 0x90, 0xf8, 0x0e, 0x3a, 0xbe, 0xce, 0x07, 0xb2, 0xc1, 0x3a, 0x02, 0xa4, 0xf3, 0x79,
+	0x66, 0x9f, 0x51, 0xa4, 0x90, 0x0f, 0x88, 0x24, 0x3a, 0x6e, 0x81, 0xc1, 0x04, 0x5d, 0x56, 0xee,
+	0x7b, 0xe3, 0x54, 0xb0, 0xe1, 0x06, 0x13, 0x49, 0xe0, 0xa5, 0x40, 0x9f, 0x01, 0xfa, 0xad, 0x3c,
+	0xb3, 0xaf, 0x2b, 0xba, 0x7e, 0x6d, 0x29, 0x20, 0xc9, 0x50, 0x41, 0xb5, 0xe0, 0x34, 0x15, 0xfc,
+	0xa7, 0x0e, 0xba, 0xd1, 0x62, 0x7b, 0x1a, 0xc9, 0x91, 0xe1, 0x21, 0x15, 0xcc, 0x07, 0x6f, 0x47,
+	0xc1, 0xdb, 0x4a, 0x9e, 0xd9, 0x77, 0xf7, 0xf3, 0x16, 0x18, 0x3c, 0xed, 0xfa, 0x30, 0xf2, 0xf8,
+	0xaf, 0x1d, 0x74, 0x4b, 0xe1, 0xd6, 0xa9, 0x60, 0x91, 0x37, 0xde, 0xda, 0x49, 0xf8, 0x68, 0xb0,
+	0x13, 0x8f, 0xc4, 0x56, 0x30, 0x64, 0x29, 0x4b, 0x02, 0xa6, 0xba, 0x7d, 0x0c, 0x02, 0x79, 0x98,
+	0x67, 0xf6, 0xfd, 0x4a, 0x20, 0xa1, 0xe2, 0x11, 0x51, 0x12, 0x89, 0x28, 0x99, 0x3a, 0x94, 0xc3,
+	0xb9, 0xc0, 0x7f, 0x40, 0x4b, 0x15, 0xe0, 0xe3, 0x20, 0x15, 0x49, 0xd0, 0x1f, 0x89, 0x80, 0x47,
+	0x8f, 0xc2, 0x10, 0xc2, 0x78, 0x1b, 0xc2, 0xb8, 0x97, 0x67, 0xf6, 0x7b, 0xad, 0x61, 0xf8, 0x06,
+	0x87, 0xd0, 0x30, 0xd4, 0x11, 0x1c, 0x28, 0x8c, 0xbf, 0xec, 0xa0, 0xdb, 0x53, 0x41, 0x9b, 0x2c,
+	0xf1, 0x58, 0x24, 0x82, 0x90, 0x41, 0x10, 0xc7, 0x21, 0x88, 0x0f, 0xf3, 0xcc, 0x5e, 0x39, 0x38,
+	0x88, 0xb8, 0xe4, 0xea, 0x58, 0x0e, 0xeb, 0x06, 0xff, 0xb9, 0x83, 0x6e, 0x4e, 0xc5, 0xf6, 0x46,
+	0xc3, 0x21, 0x4d, 0xc6, 0x10, 0xcf, 0x2c, 0xc4, 0xd3, 0xcd, 0x33, 0xfb, 0xde, 0xc1, 0xf1, 0xa4,
+	0x8a, 0xa8, 0x83, 0x39, 0x94, 0x03, 0x1c, 0xa3, 0x85, 0x0a, 0x6e, 0x75, 0xfc, 0x8c, 0x8d, 0x3f,
+	0x19, 0x0d, 0xfb, 0x2c, 0x81, 0x00, 0x4e, 0x40, 0x00, 0xef, 0xe7, 0x99, 0xbd, 0xdc, 0x1a, 0x40,
+	0x7f, 0x4c, 0x76, 0xd9, 0x98, 0x44, 0xc0, 0xd0, 0x9e, 0xf7, 0x55, 0xc4, 0x63, 0x64, 0xf7, 0x58,
+	0xf2, 0x8a, 0x25, 0x8f, 0x83, 0x74, 0xb7, 0x17, 0x53, 0x8f, 0x7d, 0x9a, 0xd2, 0x01, 0x33, 0x7b,
+	0x8d, 0xea, 0x53, 0x21, 0x05, 0x82, 0xec, 0xed, 0x2e, 0x49, 0x25, 0x85, 0x8c, 0x24, 0xa7, 0xd6,
+	0xe3, 0x83, 0x74, 0xf1, 0xaf, 0xd1, 0xa5, 0x8f, 0x38, 0x1f, 0x84, 0x6c, 0x2d, 0xe4, 0x23, 0x7f,
+	0x33, 0xe1, 0x5f, 0x30, 0x4f, 0x7c, 0x42, 0x87, 0xcc, 0xf2, 0xc1, 0xe3, 0xcd, 0x3c, 0xb3, 0x97,
+	0x94, 0xc7, 0x01, 0xe0, 0x88, 0x27, 0x81, 0x24, 0x56, 0x48, 0x12, 0xd1, 0x21, 0x73, 0xdc, 0x29,
+	0x1a, 0x78, 0x1b, 0x5d, 0x31, 0x2c, 0x3d, 0xc1, 0x13, 0x3a, 0x60, 0xcf, 0x98, 0xea, 0x12, 0x03,
+	0x07, 0xcb, 0x79, 0x66, 0xdf, 0x6c, 0x71, 0x90, 0x2a, 0x30, 0x0c, 0xa5, 0xea, 0xcb, 0x74, 0x29,
+	0xfc, 0x10, 0x5d, 0x6c, 0x35, 0x5a, 0xdb, 0xd2, 0x87, 0xdb, 0x6e, 0xc4, 0x1c, 0x2d, 0x34, 0x0d,
+	0xab, 0x23, 0x6f, 0x97, 0xa9, 0x11, 0x18, 0x40, 0x80, 0xef, 0xe5, 0x99, 0x7d, 0x7b, 0x9f, 0x00,
+	0xfb, 0x40, 0xd0, 0x03, 0xb1, 0xaf, 0x20, 0x1e, 0xa1, 0xc5, 0xa6, 0xbd, 0x37, 0xea, 0x3f, 0x0e,
+	0x12, 0xe6, 0x09, 0x9e, 0x8c, 0xad, 0x1d, 0x70, 0x79, 0x27, 0xcf, 0xec, 0x77, 0xf7, 0x71, 0x99,
+	0x8e, 0xfa, 0xc4, 0x2f, 0x38, 0x8e, 0x7b, 0x80, 0xa8, 0xf3, 0xfd, 0x02, 0xba, 0xd1, 0xb2, 0xcb,
+	0xac, 0xb2, 0xc8, 0xdb, 0x19, 0xd2, 0x64, 0xf7, 0x79, 0x2c, 0x97, 0x40, 0x8a, 0x6f, 0xa0, 0xa3,
+	0x5b, 0xe3, 0x98, 0xe9, 0x8d, 0xe6, 0x4c, 0x9e, 0xd9, 0x73, 0x2a, 0x08, 0x31, 0x8e, 0x99, 0xe3,
+	0x82, 0x11, 0xff, 0x14, 0x9d, 0x72, 0xd9, 0xef, 0x47, 0x2c, 0x15, 0x6a, 0x02, 0xc3, 0x0e, 0x33,
+	0xb3, 0x7a, 0x25, 0xcf, 0xec, 0x8b, 0x0a, 0x9d, 0x28, 0xb3, 0x5e, 0x00, 0x8e, 0x5b, 0xc5, 0xe3,
+	0x8f, 0xd1, 0xd9, 0x35, 0x1e, 0x45, 0xcc, 0x93, 0x4e, 0xb5, 0xc6, 0x0c, 0x68, 0x2c, 0xe4, 0x99,
+	0x6d, 0xe9, 0x25, 0x55, 0x22, 0x4a, 0x99, 0x06, 0x0b, 0xff, 0x18, 0x9d, 0x54, 0x1d, 0xd2, 0x2a,
+	0x47, 0x41, 0xc5, 0xca, 0x33, 0xfb, 0x42, 0x65, 0x61, 0x16, 0x0a, 0x15, 0x34, 0xfe, 0x2d, 0xba,
+	0x3c, 0x51, 0x34, 0x2d, 0xa9, 0x75, 0x6c, 0x69, 0x66, 0x79, 0xc6, 0x9c, 0xfa, 0x46, 0x38, 0x15,
+	0xcd, 0x54, 0x6e, 0x7a, 0xed, 0x22, 0x38, 0x40, 0xf3, 0x2e, 0x15, 0x6c, 0x3d, 0x18, 0x06, 0x42,
+	0x8f, 0x40, 0xba, 0xc9, 0x92, 0x1e, 0xf3, 0x78, 0xe4, 0x43, 0x6a, 0x9f, 0x59, 0x7d, 0x37, 0xcf,
+	0xec, 0x5b, 0x7a, 0xd4, 0xa8, 0x60, 0x24, 0x94, 0x60, 0xa2, 0x07, 0x30, 0x95, 0xd9, 0x94, 0xa4,
+	0x80, 0x77, 0xdc, 0x7d, 0xc4, 0xe4, 0x7e, 0xdf, 0xa3, 0x43, 0x98, 0xf0, 0x32, 0x5b, 0xcf, 0x9a,
+	0xfb, 0x7d, 0x4a, 0x87, 0xb0, 0x88, 0x1c, 0xb7, 0xc0, 0xe0, 0x9f, 0xa0, 0x93, 0xcf, 0xd8, 0xb8,
+	0x17, 0xbc, 0x61, 0xab, 0x63, 0xc1, 0x52, 0x6b, 0xb6, 0xfe, 0x06, 0xe5, 0x9a, 0x4b, 0x83, 0x37,
+	0x8c, 0xf4, 0xa5, 0xdd, 0x71, 0x2b, 0x70, 0xbc, 0x86, 0x4e, 0xbf, 0xa0, 0xe1, 0x88, 0x4d, 0x04,
+	0x4e, 0x80, 0xc0, 0xd5, 0x3c, 0xb3, 0x2f, 0x2b, 0x81, 0x57, 0xd2, 0x5e, 0x91, 0xa8, 0x51, 0x70,
+	0x17, 0x9d, 0xe8, 0x09, 0x1a, 0x32, 0x97, 0x51, 0x1f, 0x92, 0xdb, 0xec, 0xea, 0xc5, 0x3c, 0xb3,
+	0xcf, 0xe9, 0xa0, 0xa5, 0x89, 0x24, 0x8c, 0xfa, 0x8e, 0x3b, 0xc1, 0xe1, 0x1f, 0xa2, 0x39, 0xf9,
+	0x57, 0xef, 0x1c, 0xd6, 0x1c, 0xb8, 0xbd, 0x9c, 0x67, 0xf6, 0xf9, 0x62, 0xe6, 0x51, 0xbf, 0xd8,
+	0x82, 0x1c, 0xd7, 0xc4, 0x16, 0x7d, 0x96, 0x39, 0x50, 0x06, 0x61, 0x9d, 0x6c, 0xed, 0xb3, 0x34,
+	0x43, 0xd8, 0xba, 0xcf, 0x05, 0x1c, 0x3c, 0xd3, 0x68, 0xc0, 0x74, 0x29, 0x76, 0x0a, 0x56, 0x88,
+	0xe9, 0x59, 0x1a, 0xcb, 0x5a, 0xcc, 0xc4, 0xca, 0x22, 0x0e, 0x1e, 0xe1, 0xdd, 0x59, 0xa7, 0xc1,
+	0xaf, 0x51, 0xc4, 0x29, 0x26, 0xbc, 0x78, 0xc7, 0x35, 0x90, 0x72, 0xa1, 0x7d, 0x46, 0x85, 0xb7,
+	0xc3, 0x12, 0x3d, 0xbd, 0xcf, 0xd4, 0x43, 0x7e, 0xad, 0xcc, 0x93, 0x85, 0x56, 0xc1, 0xe3, 0x9f,
+	0xa3, 0x33, 0xeb, 0x8c, 0xa6, 0x6c, 0x6b, 0x6b, 0x5d, 0xcd, 0x93, 0xd4, 0x3a, 0x5b, 0x5f, 0x67,
+	0xa1, 0x04, 0x10, 0x21, 0x42, 0x3d, 0xcf, 0x52, 0xc7, 0xad, 0x93, 0xf0, 0xe7, 0xe8, 0x22, 0x34,
+	0x3d, 0x63, 0x2c, 0x7e, 0x14, 0x06, 0xaf, 0x58, 0xa1, 0x76, 0x0e, 0xd4, 0x6e, 0xe4, 0x99, 0x6d,
+	0x9b, 0x6a, 0xb2, 0xb2, 0x26, 0x54, 0x02, 0x27, 0xa2, 0xed, 0x0a, 0xf8, 0x09, 0x3a, 0xf3, 0x8c,
+	0x55, 0x36, 0x62, 0x0b, 0xc3, 0xd0, 0x1a, 0x73, 0x69, 0x97, 0x55, 0xf7, 0x74, 0xc7, 0xad, 0x73,
+	0xe4, 0xdb, 0x79, 0x19, 0xc4, 0xdb, 0x01, 0x8d, 0x7a, 0xbb, 0xec, 0xb5, 0x75, 0x7e, 0xa9, 0xb3,
+	0xdc, 0x31, 0xdf, 0xce, 0x1b, 0x65, 0x24, 0xe9, 0x2e, 0x7b, 0xed, 0xb8, 0x26, 0x16, 0xaf, 0xa3,
+	0x73, 0x1f, 0x73, 0x91, 0xc6, 0x5c, 0xc8, 0xbd, 0x44, 0x4f, 0xac, 0x0b, 0xd0, 0xb1, 0xc5, 0x3c,
+	0xb3, 0xe7, 0x95, 0xc0, 0x8e, 0x82, 0xa8, 0xcd, 0xa8, 0x98, 0x5f, 0x4d, 0x22, 0xfe, 0x25, 0xba,
+	0xa8, 0x1b, 0xf5, 0x22, 0x2d, 0x14, 0x2f, 0x82, 0xa2, 0x93, 0x67, 0xf6, 0x62, 0x55, 0xb1, 0x48,
+	0x96, 0xa5, 0x6a, 0xbb, 0x80, 0x9e, 0x45, 0x3e, 0x1f, 0xf6, 0x18, 0xf3, 0xad, 0x4b, 0x2d, 0xb3,
+	0xc8, 0xe7, 0x43, 0x92, 0x32, 0xe6, 0x3b, 0xae, 0x81, 0x94, 0x11, 0x95, 0x2b, 0xaf, 0x32, 0xce,
+	0x97, 0x61, 0x9c, 0x8d, 0x88, 0x8c, 0x35, 0x5b, 0x1d, 0xee, 0x76, 0x01, 0xbc, 0x81, 0xce, 0x95,
+	0x86, 0x8d, 0x20, 0x52, 0x99, 0xc0, 0x82, 0xc0, 0xec, 0x3c, 0xb3, 0xaf, 0x36, 0x54, 0x87, 0x41,
+	0x54, 0x64, 0x83, 0x26, 0xb3, 0x2a, 0x47, 0xf7, 0x94, 0xdc, 0x95, 0xfd, 0xe4, 0xe8, 0x5e, 0x8b,
+	0x9c, 0x66, 0xe2, 0x17, 0xe8, 0x42, 0xd9, 0xd8, 0x13, 0xbe, 0xcf, 0x5e, 0x29, 0xc5, 0xf9, 0xfa,
+	0x8b, 0x30, 0x14, 0x53, 0xc0, 0x15, 0xa2, 0xad, 0x7c, 0x59, 0x2f, 0x95, 0xed, 0x1f, 0x07, 0xa9,
+	0xe0, 0x83, 0x84, 0x0e, 0xa1, 0x9c, 0xb9, 0x5a, 0xaf, 0x97, 0x0c, 0xe5, 0x9d, 0x02, 0xa9, 0x4b,
+	0x99, 0x29, 0x1a, 0xf8, 0x67, 0xe8, 0x14, 0x58, 0x36, 0x39, 0x0f, 0xa5, 0xd5, 0x5a, 0x80, 0x70,
+	0xe7, 0xf3, 0xcc, 0xbe, 0x64, 0x8a, 0xc6, 0x9c, 0x87, 0x3a, 0x4f, 0x55, 0x09, 0x78, 0x4b, 0xf7,
+	0x7b, 0x8d, 0x0f, 0xe3, 0x84, 0xa5, 0x69, 0xd0, 0x0f, 0xc2, 0x40, 0x8c, 0xad, 0x6b, 0xb0, 0x26,
+	0x96, 0xf2, 0xcc, 0x5e, 0x30, 0x85, 0xbc, 0x2a, 0xcc, 0x71, 0x5b, 0xd9, 0x72, 0x9d, 0x3e, 0x1e,
+	0x25, 0x14, 0x2a, 0x65, 0xbd, 0xf8, 0x17, 0xeb, 0x39, 0xdf, 0xd7, 0x00, 0x23, 0x93, 0xd4, 0x38,
+	0xf8, 0x3e, 0x9a, 0x7d, 0x1e, 0xb3, 0x68, 0x9d, 0xf3, 0xd8, 0xb2, 0x21, 0xe7, 0x5f, 0xc8, 0x33,
+	0xfb, 0xac, 0xe2, 0xf3, 0x98, 0x45, 0x24, 0xe4, 0x3c, 0x76, 0xdc, 0x12, 0xa5, 0x92, 0x60, 0x32,
+	0x1c, 0xc5, 0x85, 0xdb, 0xa5, 0x66, 0x12, 0x94, 0xe6, 0x89, 0xd3, 0x2a, 0x1e, 0xaf, 0xa2, 0xd3,
+	0xaa, 0xa1, 0xd8, 0x35, 0xad, 0xeb, 0xf5, 0x21, 0xd5, 0x0a, 0xc5, 0xae, 0xeb, 0xb8, 0x35, 0x86,
+	0xec, 0xfd, 0x1a, 0xe7, 0xa1, 0xcf, 0x5f, 0x97, 0xbd, 0x77, 0xea, 0xbd, 0xf7, 0x34, 0xc0, 0xe8,
+	0x7d, 0x8d, 0xa3, 0x0a, 0x1f, 0xd5, 0x54, 0x06, 0x73, 0xa3, 0x59, 0xf8, 0x68, 0x9d, 0x49, 0x38,
+	0x0d, 0x16, 0x1e, 0xa0, 0x79, 0xfd, 0xbf, 0x3c, 0x54, 0xf2, 0x91, 0xd8, 0x08, 0xc2, 0x30, 0xd0,
+	0x9e, 0xad, 0x9b, 0xa0, 0x79, 0x3b, 0xcf, 0xec, 0x1b, 0xd5, 0x82, 0x4c, 0x28, 0x30, 0x19, 0x1a,
+	0x68, 0x59, 0x58, 0x4c, 0x95, 0xc2, 0x4f, 0xd1, 0x59, 0x97, 0x89, 0x64, 0xbc, 0x41, 0xf7, 0x1e,
+	0x09, 0xc1, 0x86, 0xb1, 0x48, 0xad, 0x5b, 0x20, 0x7f, 0x2d, 0xcf, 0xec, 0x2b, 0x85, 0xbc, 0x48,
+	0xc6, 0xb0, 0x1c, 0xa9, 0xc6, 0x38, 0x6e, 0x83, 0x86, 0x29, 0xb2, 0xa0, 0x6d, 0x95, 0x7a, 0xbb,
+	0x7c, 0x7b, 0xbb, 0x12, 0xf1, 0x3b, 0x20, 0x69, 0xdc, 0x32, 0x28, 0xc9, 0xbe, 0x82, 0xd6, 0xe2,
+	0x9d, 0x2a, 0x83, 0x77, 0xd1, 0xd5, 0xc2, 0x6d, 0x9b, 0x97, 0xdb, 0x8d, 0x92, 0xab, 0x0c, 0xbc,
+	0xdd, 0xd3, 0x7e, 0x6a, 0xf8, 0x53, 0x74, 0x01, 0xcc, 0x4f, 0x92, 0x84, 0x27, 0x6b, 0x54, 0xb0,
+	0x01, 0x97, 0x67, 0x7b, 0x6b, 0x79, 0x69, 0x66, 0xf9, 0xc4, 0xea, 0xf5, 0x3c, 0xb3, 0xaf, 0x99,
+	0x5e, 0x98, 0x84, 0x11, 0xaf, 0xc4, 0x39, 0x6e, 0x2b, 0x1d, 0x7f, 0x81, 0xe6, 0xd6, 0x39, 0x95,
+	0x87, 0xa8, 0xed, 0x20, 0x64, 0xd6, 0xbb, 0x4b, 0x33, 0xcb, 0x73, 0x2b, 0xf7, 0xee, 0x4e, 0xee,
+	0x91, 0xee, 0xb6, 0x54, 0xf2, 0x06, 0xa3, 0x27, 0xe4, 0x91, 0xc3, 0xd8, 0xfb, 0x42, 0x4e, 0xe1,
+	0xb4, 0x26, 0x8d, 0x8e, 0x6b, 0x8a, 0xe3, 0xdf, 0xa0, 0x13, 0xbd, 0xf5, 0xe7, 0x3d, 0x46, 0x13,
+	0x6f, 0xc7, 0xfa, 0xc1, 0x52, 0x67, 0x79, 0x6e, 0x65, 0xf9, 0x00, 0x4f, 0x25, 0xbe, 0x52, 0xad,
+	0x85, 0x9c, 0xa4, 0xd0, 0x2a, 0xab, 0xb5, 0x02, 0x21, 0xcb, 0xf3, 0x17, 0x2c, 0x09, 0xb6, 0xc7,
+	0x9f, 0x25, 0x81, 0x4c, 0xbd, 0xef, 0xc1, 0x8a, 0x37, 0xca, 0xf3, 0x57, 0x60, 0x25, 0xaf, 0xc1,
+	0xec, 0xb8, 0x15, 0xb4, 0x9c, 0x7a, 0xea, 0xb9, 0x47, 0x87, 0x71, 0xa8, 0x8a, 0xb6, 0xf7, 0xeb,
+	0x53, 0x4f, 0x2b, 0xa4, 0x00, 0xd1, 0x09, 0xb1, 0x41, 0x53, 0x65, 0xa3, 0xc7, 0x13, 0x7f, 0x2b,
+	0xa1, 0x1e, 0xb3, 0xee, 0x40, 0x1c, 0x95, 0xb2, 0x51, 0x1a, 0x89, 0x90, 0x56, 0x28, 0x1b, 0x4b,
+	0xac, 0xac, 0xa1, 0x5c, 0x16, 0x87, 0x74, 0x0c, 0x8f, 0x90, 0xe7, 0xef, 0x42, 0x9e, 0x37, 0x96,
+	0x6c, 0x02, 0x00, 0x45, 0xd7, 0xf9, 0xbd, 0x4e, 0x52, 0xa7, 0x26, 0xd9, 0xd4, 0x8b, 0x19, 0xf3,
+	0x47, 0xb1, 0x75, 0x0f, 0xf2, 0x71, 0xe5, 0xd4, 0x04, 0x2a, 0xa9, 0xb2, 0xc3, 0xa9, 0xc9, 0xc0,
+	0xcb, 0x12, 0x7f, 0x6b, 0x2f, 0xda, 0xe0, 0x3e, 0xb3, 0xee, 0xd7, 0xaf, 0xf4, 0xc4, 0x5e, 0x44,
+	0x86, 0xdc, 0x67, 0x8e, 0x5b, 0x60, 0x64, 0x79, 0x0d, 0xc5, 0x20, 0x10, 0x1e, 0x00, 0xc1, 0x78,
+	0x61, 0x50, 0x38, 0x6a, 0xca, 0x04, 0xa7, 0x72, 0xa5, 0x51, 0x41, 0xa6, 0xd6, 0xca, 0xd2, 0x4c,
+	0x3d, 0x57, 0x9a, 0x25, 0xa7, 0xca, 0x95, 0x26, 0xc3, 0xf9, 0xc7, 0x31, 0xb4, 0xb0, 0xdf, 0xbc,
+	0xc1, 0xcf, 0xd0, 0x39, 0x7d, 0x0b, 0x32, 0xb9, 0x00, 0x82, 0x13, 0x67, 0xc7, 0x7c, 0xb1, 0xc5,
+	0x5d, 0xca, 0xe4, 0x3e, 0xc9, 0x71, 0x9b, 0x3c, 0xfc, 0x2b, 0x74, 0x69, 0x83, 0xee, 0xe9, 0xf6,
+	0xca, 0x62, 0x3f, 0x02, 0x8a, 0x46, 0x6d, 0x2a, 0x97, 0x79, 0xa1, 0x5a, 0x5d, 0xe6, 0x53, 0x24,
+	0xe4, 0xbb, 0xdf, 0xa0, 0x7b, 0xb0, 0x40, 0x8b, 0x32, 0x6e, 0x06, 0x54, 0x8d, 0x77, 0x2f, 0x55,
+	0xd5, 0xd2, 0x2e, 0x0b, 0xb8, 0x3a, 0x49, 0x66, 0x8a, 0x8d, 0x20, 0x9a, 0x5c, 0x04, 0x16, 0x62,
+	0x47, 0x41, 0xcc, 0xc8, 0x14, 0xb2, 0x40, 0x32, 0x6e, 0x16, 0x4b, 0xc5, 0x56, 0xba, 0x5c, 0x5e,
+	0x9b, 0x09, 0xef, 0x97, 0xd5, 0xf8, 0xb1, 0xfa, 0xe9, 0x37, 0x96, 0xd6, 0xc9, 0x7e, 0x54, 0x41,
+	0xcb, 0xfa, 0x68, 0x23, 0x88, 0xa6, 0x9d, 0x4b, 0x8d, 0xfa, 0x48, 0x06, 0xd5, 0x7a, 0x20, 0x6d,
+	0xe5, 0x83, 0x2e, 0xdd, 0x6b, 0xea, 0x1e, 0x6f, 0xe8, 0xd2, 0xbd, 0x69, 0xba, 0x2d, 0x7c, 0x95,
+	0xdb, 0x53, 0x1e, 0x42, 0xed, 0xd9, 0x94, 0x9f, 0x6d, 0xe6, 0xf6, 0x02, 0xdc, 0xee, 0x65, 0x3f,
+	0x35, 0xe7, 0x8f, 0xc7, 0x5a, 0x2f, 0x4c, 0xea, 0x69, 0xf6, 0x70, 0x17, 0x26, 0x2d, 0xb5, 0xd3,
+	0x91, 0xff, 0xa3, 0x76, 0x5a, 0x47, 0xe7, 0x9a, 0xdd, 0x9e, 0xa9, 0x1f, 0x54, 0x5a, 0xfb, 0xda,
+	0x24, 0xca, 0x32, 0xb6, 0x27, 0x68, 0xd2, 0x72, 0x31, 0xa1, 0x2e, 0x51, 0x8c, 0x32, 0x36, 0x95,
+	0xb8, 0xf6, 0x41, 0x9c, 0xa2, 0x81, 0x77, 0xd0, 0xfc, 0xa3, 0x61, 0x1c, 0x06, 0x62, 0xe4, 0xb3,
+	0xa6, 0x07, 0x35, 0x51, 0x8d, 0x7b, 0x3f, 0x5a, 0x60, 0xa7, 0xdc, 0x7c, 0x4c, 0xd7, 0x92, 0x79,
+	0x75, 0x93, 0x25, 0x01, 0xf7, 0x8b, 0xa1, 0x7d, 0xbb, 0x5e, 0x1f, 0xc6, 0x60, 0x36, 0xea, 0xc3,
+	0x0a, 0x5e, 0x1e, 0x6e, 0x37, 0x19, 0xdd, 0x9d, 0x36, 0x61, 0x8d, 0x04, 0x12, 0x33, 0xba, 0xdb,
+	0x1e, 0x60, 0xbb, 0x82, 0x5c, 0xa0, 0xbd, 0x38, 0xd8, 0x2d, 0x17, 0xe8, 0x6c, 0x7d, 0x81, 0xa6,
+	0xd2, 0x6a, 0x2c, 0x50, 0x13, 0xed, 0x64, 0x47, 0xd0, 0xf5, 0xfd, 0x2e, 0xed, 0x7a, 0x82, 0xc5,
+	0x29, 0x7e, 0x8e, 0xb0, 0xfc, 0xe7, 0x01, 0xbc, 0x88, 0xc7, 0x54, 0xd0, 0x3e, 0x4d, 0xd5, 0x7c,
+	0x9c, 0x35, 0x8f, 0x4d, 0xa9, 0xc4, 0x10, 0xf5, 0x26, 0x7d, 0x8d, 0x72, 0xdc, 0x16, 0x2a, 0x76,
+	0xd1, 0x79, 0xd9, 0xba, 0xd2, 0x13, 0xb2, 0xfe, 0x2f, 0x15, 0x8f, 0x80, 0xa2, 0x71, 0x7c, 0x90,
+	0x8a, 0x2b, 0x24, 0x05, 0x94, 0x21, 0xd9, 0x46, 0x96, 0x53, 0x57, 0x36, 0x77, 0x7b, 0x82, 0xc7,
+	0xa5, 0xe2, 0x0c, 0x28, 0x1a, 0x53, 0x57, 0x2a, 0x76, 0xe5, 0x15, 0x67, 0x6c, 0xe8, 0x35, 0x89,
+	0x32, 0x2d, 0xcb, 0xc6, 0x87, 0x9f, 0xc6, 0xb2, 0xb4, 0x59, 0xe7, 0x83, 0x14, 0xe6, 0xec, 0xac,
+	0x99, 0x96, 0xa5, 0xd6, 0x43, 0x32, 0x02, 0x04, 0x09, 0xf9, 0x40, 0x2e, 0xa8, 0x1a, 0xc9, 0xf9,
+	0xe6, 0x0c, 0xb2, 0x5b, 0x06, 0xf8, 0xd1, 0x80, 0x45, 0x62, 0x8d, 0x47, 0x22, 0xe1, 0xf0, 0x01,
+	0xae, 0xf0, 0xfb, 0xf4, 0x71, 0xf3, 0x03, 0x5c, 0x11, 0x27, 0x09, 0xe4, 0xa9, 0x7b, 0x82, 0xc4,
+	0xbf, 0x40, 0xe7, 0x8b, 0xa7, 0xc7, 0x2c, 0xf5, 0x92, 0x00, 0x6e, 0x58, 0xf5, 0xc7, 0x38, 0xe3,
+	0xbd, 0x94, 0x02, 0xfe, 0x04, 0xe5, 0xb8, 0x6d, 0x5c, 0x59, 0xc4, 0x14, 0xcd, 0x5b, 0x74, 0xa0,
+	0x3f, 0xcc, 0x19, 0x45, 0x4c, 0x29, 0x25, 0xe8, 0xc0, 0x71, 0x4d, 0xac, 0xac, 0x1d, 0x36, 0x19,
+	0x4b, 0x9e, 0x6e, 0xca, 0x91, 0x9a, 0xa9, 0xd6, 0x0e, 0x31, 0x63, 0x09, 0x09, 0xe2, 0xd4, 0x71,
+	0x0b, 0x8c, 0x3c, 0x84, 0xea, 0x7f, 0x7b, 0x22, 0x09, 0xa2, 0x81, 0xfe, 0x1a, 0x66, 0x54, 0x01,
+	0x05, 0x49, 0xbe, 0xff, 0x20, 0x1a, 0x38, 0x6e, 0x95, 0x80, 0x37, 0x11, 0x86, 0x61, 0xdc, 0xe4,
+	0x89, 0xd8, 0xe2, 0xfa, 0x82, 0x54, 0x2f, 0x4d, 0x63, 0x0e, 0x51, 0x89, 0x21, 0x31, 0x4f, 0x04,
+	0x11, 0x9c, 0xe8, 0x3b, 0x56, 0xc7, 0x6d, 0xe1, 0xca, 0xd2, 0x04, 0x5a, 0x9f, 0x44, 0x7e, 0xcc,
+	0x83, 0x48, 0xa4, 0xd6, 0xf1, 0xa5, 0x99, 0x6a, 0x50, 0x4a, 0x8d, 0x15, 0x00, 0xc7, 0xad, 0x31,
+	0xe4, 0x52, 0x2f, 0x46, 0xa5, 0x1a, 0xd8, 0x6c, 0x7d, 0xa9, 0x97, 0x63, 0xd9, 0x88, 0xad, 0x5d,
+	0x41, 0x16, 0x35, 0x85, 0x61, 0x12, 0xe1, 0x09, 0x88, 0xd0, 0x28, 0x6a, 0x4a, 0x59, 0x23, 0xc8,
+	0x26, 0x4f, 0x16, 0x35, 0x72, 0xa7, 0xf9, 0x88, 0x45, 0x2c, 0xa1, 0x82, 0x27, 0x13, 0x45, 0x04,
+	0x8a, 0x46, 0xa0, 0x30, 0xbf, 0x07, 0x05, 0xd0, 0xd4, 0x9d, 0x22, 0x81, 0x09, 0x3a, 0x07, 0x1f,
+	0xa2, 0xe1, 0xf3, 0x38, 0x21, 0x5c, 0xec, 0xb0, 0x04, 0x3e, 0xf5, 0xcc, 0xad, 0x5c, 0x33, 0x6b,
+	0xff, 0x06, 0xc8, 0x9c, 0xf7, 0x46, 0xb3, 0xe3, 0x9e, 0x92, 0xd0, 0x27, 0xc2, 0xf3, 0x9f, 0xcb,
+	0x67, 0xfc, 0x19, 0x3a, 0x63, 0x72, 0x45, 0x10, 0xc3, 0x87, 0x9e, 0xb9, 0x95, 0xab, 0xd3, 0xe4,
+	0x45, 0x10, 0x9b, 0xf7, 0x00, 0x65, 0xa3, 0xe3, 0xce, 0x15, 0xd2, 0x5b, 0x41, 0x8c, 0x5f, 0xa2,
+	0xb3, 0x26, 0xeb, 0x55, 0x97, 0xac, 0xc0, 0xe7, 0x9d, 0xb9, 0x95, 0x85, 0x69, 0xca, 0x12, 0x63,
+	0xd6, 0xbd, 0x93, 0x56, 0x43, 0xfb, 0x45, 0x77, 0xa5, 0x45, 0xbb, 0x6b, 0x0d, 0x0e, 0xd4, 0xee,
+	0xb6, 0x6a, 0x77, 0x2b, 0xda, 0x5d, 0xfc, 0x97, 0x0e, 0x5a, 0x50, 0xc4, 0xf2, 0x57, 0x07, 0x84,
+	0x24, 0x5d, 0xf2, 0x01, 0xe9, 0x92, 0x3e, 0x13, 0xd4, 0xfa, 0xba, 0xd3, 0x3c, 0x7a, 0xed, 0x47,
+	0x30, 0x4b, 0xc6, 0x76, 0x84, 0xe3, 0x5e, 0x94, 0x02, 0x2f, 0x0b, 0xa3, 0xdb, 0xfd, 0xa0, 0xbb,
+	0xca, 0x04, 0xc5, 0x5f, 0xa0, 0x0b, 0x4a, 0x59, 0xfd, 0xbe, 0x81, 0x90, 0x57, 0x0f, 0xc8, 0x7d,
+	0xb2, 0x62, 0xfd, 0xfd, 0x08, 0x84, 0xb0, 0xd4, 0x0c, 0xa1, 0x0a, 0x34, 0x37, 0xd6, 0xaa, 0xc5,
+	0x71, 0x4f, 0x4b, 0xc2, 0x1a, 0x34, 0xbe, 0x78, 0x70, 0x7f, 0x05, 0xff, 0xae, 0x98, 0x69, 0x9e,
+	0x1a, 0x1a, 0xe8, 0xeb, 0x97, 0x33, 0xd3, 0xa6, 0x9a, 0x81, 0x32, 0xa7, 0x9a, 0xd1, 0xac, 0xa7,
+	0xda, 0x9a, 0x6c, 0x81, 0xde, 0x94, 0x1e, 0xde, 0x18, 0x1e, 0xfe, 0x3b, 0xd5, 0xc3, 0x9b, 0x76,
+	0x0f, 0x6f, 0x1a, 0x1e, 0x5e, 0x96, 0x1e, 0x5e, 0xa3, 0xcb, 0x8a, 0x5b, 0xfc, 0x6e, 0x83, 0x10,
+	0x6f, 0x0c, 0x37, 0x63, 0xd6, 0x37, 0x47, 0xc1, 0xcf, 0x8d, 0xa6, 0x9f, 0x06, 0xd6, 0xac, 0xf4,
+	0x4a, 0xa3, 0xb6, 0x39, 0xee, 0x79, 0xc9, 0xfa, 0x5c, 0x37, 0xaf, 0xa9, 0x56, 0xfc, 0xb7, 0xce,
+	0xa1, 0x3e, 0xd9, 0x59, 0xff, 0x39, 0xbe, 0xd4, 0x39, 0xc4, 0x05, 0x41, 0x9d, 0x67, 0xee, 0x95,
+	0xfd, 0xc2, 0x46, 0xb8, 0x32, 0xca, 0x5f, 0x53, 0x1c, 0x2c, 0x81, 0xbf, 0xea, 0x1c, 0xa2, 0x40,
+	0xb1, 0xbe, 0x57, 0x01, 0xde, 0x39, 0x6c, 0x80, 0xc0, 0x32, 0xd3, 0xfa, 0x24, 0x3c, 0xb9, 0xa9,
+	0xa7, 0x8e, 0x7b, 0xb0, 0xd3, 0xd5, 0x0b, 0x5f, 0xff, 0x7b, 0xf1, 0xad, 0xaf, 0xbf, 0x5d, 0xec,
+	0xfc, 0xf3, 0xdb, 0xc5, 0xce, 0xbf, 0xbe, 0x5d, 0xec, 0x7c, 0xf5, 0xdd, 0xe2, 0x5b, 0xfd, 0xb7,
+	0xe1, 0x37, 0x37, 0xdd, 0xff, 0x0d, 0x00, 0x65, 0x6f, 0x70, 0x22, 0x8d, 0x24, 0x00, 0x00,
 }
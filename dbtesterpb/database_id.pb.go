@@ -32,6 +32,8 @@ const (
 	DatabaseID_zetcd__beta DatabaseID = 300
 	// https://github.com/coreos/cetcd/releases
 	DatabaseID_cetcd__beta DatabaseID = 400
+	// https://github.com/YTsaurus/YTsaurus
+	DatabaseID_ytsaurus_cypress DatabaseID = 500
 )
 
 var DatabaseID_name = map[int32]string{
@@ -43,6 +45,7 @@ var DatabaseID_name = map[int32]string{
 	200: "consul__v1_0_2",
 	300: "zetcd__beta",
 	400: "cetcd__beta",
+	500: "ytsaurus_cypress",
 }
 var DatabaseID_value = map[string]int32{
 	"etcd__other":            0,
@@ -53,6 +56,7 @@ var DatabaseID_value = map[string]int32{
 	"consul__v1_0_2":         200,
 	"zetcd__beta":            300,
 	"cetcd__beta":            400,
+	"ytsaurus_cypress":       500,
 }
 
 func (x DatabaseID) String() string {
@@ -67,20 +71,21 @@ func init() {
 func init() { proto.RegisterFile("dbtesterpb/database_id.proto", fileDescriptorDatabaseId) }
 
 var fileDescriptorDatabaseId = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x4d, 0x4a, 0xc4, 0x40,
-	0x10, 0x85, 0xd3, 0x33, 0x20, 0x58, 0x83, 0x63, 0xd3, 0x8a, 0x8b, 0x41, 0xfa, 0x00, 0x82, 0x13,
-	0x9d, 0xc6, 0x0b, 0xc8, 0x6c, 0x3c, 0x45, 0x91, 0x4e, 0x97, 0x99, 0xe0, 0x4f, 0x85, 0x4e, 0x65,
-	0x16, 0x73, 0x0a, 0x97, 0x6e, 0xdd, 0x7b, 0x90, 0x2c, 0x3d, 0x82, 0xc6, 0x8b, 0x88, 0x1d, 0x41,
-	0xdd, 0xd5, 0xf7, 0xd5, 0xab, 0x07, 0x05, 0xa7, 0xc1, 0x0b, 0xb5, 0x42, 0xb1, 0xf1, 0x79, 0x28,
-	0xa4, 0xf0, 0x45, 0x4b, 0x58, 0x87, 0x65, 0x13, 0x59, 0xd8, 0xc0, 0xef, 0x76, 0x71, 0x5e, 0xd5,
-	0xb2, 0xe9, 0xfc, 0xb2, 0xe4, 0x87, 0xbc, 0xe2, 0x8a, 0xf3, 0x14, 0xf1, 0xdd, 0x6d, 0xa2, 0x04,
-	0x69, 0x1a, 0x4f, 0xcf, 0x5e, 0x14, 0xc0, 0xfa, 0xa7, 0xf0, 0x66, 0x6d, 0x0e, 0x61, 0x46, 0x52,
-	0x06, 0x44, 0x96, 0x0d, 0x45, 0x9d, 0x99, 0x03, 0xd8, 0x1f, 0x85, 0xd4, 0x8d, 0x56, 0x66, 0x0e,
-	0x30, 0xe2, 0xd6, 0xe1, 0x4a, 0x4f, 0xfe, 0xb1, 0xd3, 0x53, 0xb3, 0x80, 0x93, 0x1d, 0xf3, 0x1d,
-	0x51, 0x43, 0x11, 0x31, 0x3a, 0xbc, 0x42, 0x87, 0x9e, 0xa4, 0xd0, 0xc1, 0x1c, 0xc1, 0xbc, 0xe4,
-	0xc7, 0xb6, 0xbb, 0x47, 0xdc, 0x5e, 0xe2, 0x05, 0xae, 0x74, 0xaf, 0x8c, 0x86, 0xd9, 0x6e, 0x6c,
-	0x48, 0xa9, 0xd7, 0xc9, 0xb7, 0x29, 0xff, 0x98, 0xa7, 0xe9, 0xf5, 0x71, 0xff, 0x61, 0xb3, 0x7e,
-	0xb0, 0xea, 0x6d, 0xb0, 0xea, 0x7d, 0xb0, 0xea, 0xf9, 0xd3, 0x66, 0x7e, 0x2f, 0x3d, 0xe0, 0xbe,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xf3, 0x61, 0x28, 0x1b, 0x01, 0x00, 0x00,
+	// 254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x5d, 0x4a, 0xc3, 0x40,
+	0x10, 0xc7, 0xbb, 0x0d, 0x08, 0x4e, 0xb1, 0x2e, 0xeb, 0xc7, 0x43, 0x91, 0x1c, 0x40, 0xb0, 0xd1,
+	0x06, 0x2f, 0x20, 0x7d, 0xf1, 0x14, 0x43, 0x36, 0x3b, 0xa6, 0xc1, 0x8f, 0x5d, 0x76, 0x67, 0x0b,
+	0xed, 0x29, 0x7c, 0xf4, 0x10, 0x1e, 0xc0, 0x23, 0xe4, 0xd1, 0x23, 0x68, 0xbc, 0x82, 0x07, 0x10,
+	0x37, 0x82, 0xfa, 0x36, 0xbf, 0xdf, 0xfc, 0xe7, 0x0f, 0x03, 0x27, 0x46, 0x33, 0x05, 0x26, 0xef,
+	0x74, 0x61, 0x2a, 0xae, 0x74, 0x15, 0x08, 0x5b, 0x33, 0x77, 0xde, 0xb2, 0x55, 0xf0, 0xbb, 0x9d,
+	0x9d, 0x35, 0x2d, 0xaf, 0xa2, 0x9e, 0xd7, 0xf6, 0xbe, 0x68, 0x6c, 0x63, 0x8b, 0x14, 0xd1, 0xf1,
+	0x26, 0x51, 0x82, 0x34, 0x0d, 0xa7, 0xa7, 0x2f, 0x02, 0x60, 0xf9, 0x53, 0x78, 0xbd, 0x54, 0xfb,
+	0x30, 0x21, 0xae, 0x0d, 0xa2, 0xe5, 0x15, 0x79, 0x39, 0x52, 0x7b, 0xb0, 0x3b, 0x08, 0x6e, 0x9d,
+	0x14, 0x6a, 0x0a, 0x30, 0xe0, 0xba, 0xc4, 0x85, 0x1c, 0xff, 0xe3, 0x52, 0x66, 0x6a, 0x06, 0xc7,
+	0x5b, 0x6b, 0x6f, 0x89, 0x1c, 0x79, 0x44, 0x5f, 0xe2, 0x25, 0x96, 0xa8, 0x89, 0x2b, 0x69, 0xd4,
+	0x01, 0x4c, 0x6b, 0xfb, 0x10, 0xe2, 0x1d, 0xe2, 0xfa, 0x02, 0xcf, 0x71, 0x21, 0x3b, 0xa1, 0x24,
+	0x4c, 0xb6, 0x43, 0x43, 0x4a, 0x3d, 0x8f, 0xbf, 0x4d, 0xfd, 0xc7, 0x3c, 0x66, 0xea, 0x08, 0xe4,
+	0x86, 0x43, 0x15, 0x7d, 0x0c, 0x58, 0x6f, 0x9c, 0xa7, 0x10, 0xe4, 0x67, 0x76, 0x75, 0xd8, 0xbd,
+	0xe7, 0xa3, 0xae, 0xcf, 0xc5, 0x6b, 0x9f, 0x8b, 0xb7, 0x3e, 0x17, 0x4f, 0x1f, 0xf9, 0x48, 0xef,
+	0xa4, 0xbf, 0xca, 0xaf, 0x01, 0x00, 0x3a, 0xba, 0x3e, 0x7a, 0x32, 0x01, 0x00, 0x00,
 }
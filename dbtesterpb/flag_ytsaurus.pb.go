@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dbtesterpb/flag_ytsaurus.proto
+
+package dbtesterpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Flag_YTsaurus_Cypress is YTsaurus-specific flags
+// for Cypress
+// (https://github.com/YTsaurus/YTsaurus).
+type Flag_Ytsaurus_Cypress struct {
+	// RPCProxy is the address of YTsaurus RPC proxy (e.g. 'localhost:9013').
+	// Proxy discovery is disabled, so all clients connect to this proxy.
+	// Defaults to the first database endpoint.
+	RPCProxy string `protobuf:"bytes,1,opt,name=RPCProxy,proto3" json:"RPCProxy,omitempty" yaml:"rpc_proxy"`
+	// TokenPath is the path to the file with the authentication token.
+	TokenPath string `protobuf:"bytes,2,opt,name=TokenPath,proto3" json:"TokenPath,omitempty" yaml:"token_path"`
+	// TokenEnv is the environment variable with the authentication token,
+	// used when 'TokenPath' is empty. Defaults to 'YT_TOKEN'.
+	TokenEnv string `protobuf:"bytes,3,opt,name=TokenEnv,proto3" json:"TokenEnv,omitempty" yaml:"token_env"`
+	// Token is read from 'TokenPath' or 'TokenEnv' on the control machine,
+	// and on each load generator machine, since it is never sent to agents.
+	// No need to set manually.
+	Token string `protobuf:"bytes,4,opt,name=Token,proto3" json:"Token,omitempty"`
+	// RootPath is the Cypress node that stores all benchmark keys.
+	// Defaults to '//tmp/dbtester'.
+	RootPath string `protobuf:"bytes,5,opt,name=RootPath,proto3" json:"RootPath,omitempty" yaml:"root_path"`
+	// StorageMode is 'attribute' to store each key as an attribute of 'RootPath',
+	// or 'document' to store each key as a document node under 'RootPath'.
+	// Defaults to 'attribute'.
+	StorageMode string `protobuf:"bytes,6,opt,name=StorageMode,proto3" json:"StorageMode,omitempty" yaml:"storage_mode"`
+}
+
+func (m *Flag_Ytsaurus_Cypress) Reset()         { *m = Flag_Ytsaurus_Cypress{} }
+func (m *Flag_Ytsaurus_Cypress) String() string { return proto.CompactTextString(m) }
+func (*Flag_Ytsaurus_Cypress) ProtoMessage()    {}
+func (*Flag_Ytsaurus_Cypress) Descriptor() ([]byte, []int) {
+	return fileDescriptorFlagYtsaurus, []int{0}
+}
+
+func init() {
+	proto.RegisterType((*Flag_Ytsaurus_Cypress)(nil), "dbtesterpb.flag__ytsaurus__cypress")
+}
+func (m *Flag_Ytsaurus_Cypress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Flag_Ytsaurus_Cypress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RPCProxy) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFlagYtsaurus(dAtA, i, uint64(len(m.RPCProxy)))
+		i += copy(dAtA[i:], m.RPCProxy)
+	}
+	if len(m.TokenPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFlagYtsaurus(dAtA, i, uint64(len(m.TokenPath)))
+		i += copy(dAtA[i:], m.TokenPath)
+	}
+	if len(m.TokenEnv) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFlagYtsaurus(dAtA, i, uint64(len(m.TokenEnv)))
+		i += copy(dAtA[i:], m.TokenEnv)
+	}
+	if len(m.Token) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFlagYtsaurus(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if len(m.RootPath) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFlagYtsaurus(dAtA, i, uint64(len(m.RootPath)))
+		i += copy(dAtA[i:], m.RootPath)
+	}
+	if len(m.StorageMode) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFlagYtsaurus(dAtA, i, uint64(len(m.StorageMode)))
+		i += copy(dAtA[i:], m.StorageMode)
+	}
+	return i, nil
+}
+
+func encodeVarintFlagYtsaurus(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Flag_Ytsaurus_Cypress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RPCProxy)
+	if l > 0 {
+		n += 1 + l + sovFlagYtsaurus(uint64(l))
+	}
+	l = len(m.TokenPath)
+	if l > 0 {
+		n += 1 + l + sovFlagYtsaurus(uint64(l))
+	}
+	l = len(m.TokenEnv)
+	if l > 0 {
+		n += 1 + l + sovFlagYtsaurus(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovFlagYtsaurus(uint64(l))
+	}
+	l = len(m.RootPath)
+	if l > 0 {
+		n += 1 + l + sovFlagYtsaurus(uint64(l))
+	}
+	l = len(m.StorageMode)
+	if l > 0 {
+		n += 1 + l + sovFlagYtsaurus(uint64(l))
+	}
+	return n
+}
+
+func sovFlagYtsaurus(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozFlagYtsaurus(x uint64) (n int) {
+	return sovFlagYtsaurus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Flag_Ytsaurus_Cypress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFlagYtsaurus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: flag__ytsaurus__cypress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: flag__ytsaurus__cypress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPCProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RPCProxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenEnv = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFlagYtsaurus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFlagYtsaurus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFlagYtsaurus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFlagYtsaurus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFlagYtsaurus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthFlagYtsaurus
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowFlagYtsaurus
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipFlagYtsaurus(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthFlagYtsaurus = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFlagYtsaurus   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("dbtesterpb/flag_ytsaurus.proto", fileDescriptorFlagYtsaurus) }
+
+var fileDescriptorFlagYtsaurus = []byte{
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x29, 0x0a, 0x91, 0x71, 0xa3, 0x15, 0x43, 0xe3, 0x62, 0x30, 0xb3, 0x72, 0x23, 0x98,
+	0xb0, 0xd2, 0x25, 0xc6, 0xa5, 0x09, 0x19, 0xdd, 0x4f, 0xa6, 0x30, 0x14, 0x23, 0xf0, 0x26, 0x33,
+	0x53, 0x62, 0x6f, 0xe2, 0x45, 0xbc, 0x03, 0x4b, 0x4f, 0xd0, 0x68, 0xbd, 0x41, 0x4f, 0x60, 0xfa,
+	0xc6, 0x14, 0xa3, 0xbb, 0xf7, 0xe7, 0xff, 0xfe, 0xf7, 0xbf, 0x3c, 0x42, 0x67, 0xb1, 0x53, 0xd6,
+	0x29, 0xa3, 0xe3, 0xe1, 0x7c, 0x29, 0x13, 0x91, 0x39, 0x2b, 0x53, 0x93, 0xda, 0x81, 0x36, 0xe0,
+	0x20, 0x24, 0x3b, 0xff, 0xec, 0x32, 0x79, 0x72, 0x8b, 0x34, 0x1e, 0x4c, 0x61, 0x35, 0x4c, 0x20,
+	0x81, 0x21, 0x22, 0x71, 0x3a, 0x47, 0x85, 0x02, 0x27, 0x1f, 0x65, 0x6f, 0x4d, 0xd2, 0xc3, 0x95,
+	0xf5, 0x4e, 0x21, 0xa6, 0x99, 0x36, 0xca, 0xda, 0xf0, 0x8a, 0x1c, 0xf0, 0xc9, 0xed, 0xc4, 0xc0,
+	0x4b, 0x16, 0x05, 0xe7, 0xc1, 0x45, 0x67, 0xdc, 0x2d, 0xf3, 0xfe, 0x51, 0x26, 0x57, 0xcb, 0x1b,
+	0x66, 0xf4, 0x54, 0xe8, 0xca, 0x62, 0xbc, 0xa6, 0xc2, 0x11, 0xe9, 0x3c, 0xc2, 0xb3, 0x5a, 0x4f,
+	0xa4, 0x5b, 0x44, 0x4d, 0x8c, 0x9c, 0x96, 0x79, 0xff, 0xd8, 0x47, 0x5c, 0x65, 0x09, 0x2d, 0xdd,
+	0x82, 0xf1, 0x1d, 0x57, 0xd5, 0xa0, 0xb8, 0x5b, 0x6f, 0xa2, 0xbd, 0xbf, 0x35, 0x3e, 0xa3, 0xd6,
+	0x1b, 0xc6, 0x6b, 0x2a, 0xec, 0x92, 0x16, 0xce, 0xd1, 0x7e, 0x85, 0x73, 0x2f, 0xf0, 0x5c, 0x00,
+	0x87, 0xdd, 0xad, 0x7f, 0xe7, 0x02, 0xb8, 0x9f, 0xea, 0x9a, 0x0a, 0xaf, 0xc9, 0xe1, 0x83, 0x03,
+	0x23, 0x13, 0x75, 0x0f, 0x33, 0x15, 0xb5, 0x31, 0xd4, 0x2b, 0xf3, 0xfe, 0x89, 0x0f, 0x59, 0x6f,
+	0x8a, 0x15, 0xcc, 0x14, 0xe3, 0xbf, 0xd9, 0x71, 0x77, 0xfb, 0x49, 0x1b, 0xdb, 0x82, 0x06, 0xef,
+	0x05, 0x0d, 0x3e, 0x0a, 0x1a, 0xbc, 0x7e, 0xd1, 0x46, 0xdc, 0xc6, 0xa7, 0x8e, 0xbe, 0x07, 0x00,
+	0x3c, 0xde, 0x23, 0xaf, 0xb1, 0x01, 0x00, 0x00,
+}
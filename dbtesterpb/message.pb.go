@@ -24,17 +24,35 @@ const (
 	Operation_Start     Operation = 0
 	Operation_Stop      Operation = 1
 	Operation_Heartbeat Operation = 2
+	// StressPrepare prepares the part of the benchmark on the load generator
+	// (e.g. preloading keys), and returns when ready to send requests.
+	Operation_StressPrepare Operation = 3
+	// StressStart starts the requests at 'StartUnixNano'.
+	Operation_StressStart Operation = 4
+	// StressResult returns the stats after all requests are finished.
+	Operation_StressResult Operation = 5
+	// StressCancel stops the requests, so that "StressResult" returns
+	// the stats so far.
+	Operation_StressCancel Operation = 6
 )
 
 var Operation_name = map[int32]string{
 	0: "Start",
 	1: "Stop",
 	2: "Heartbeat",
+	3: "StressPrepare",
+	4: "StressStart",
+	5: "StressResult",
+	6: "StressCancel",
 }
 var Operation_value = map[string]int32{
-	"Start":     0,
-	"Stop":      1,
-	"Heartbeat": 2,
+	"Start":         0,
+	"Stop":          1,
+	"Heartbeat":     2,
+	"StressPrepare": 3,
+	"StressStart":   4,
+	"StressResult":  5,
+	"StressCancel":  6,
 }
 
 func (x Operation) String() string {
@@ -54,14 +72,25 @@ type Request struct {
 	IPIndex                    uint32                      `protobuf:"varint,6,opt,name=IPIndex,proto3" json:"IPIndex,omitempty"`
 	CurrentClientNumber        int64                       `protobuf:"varint,7,opt,name=CurrentClientNumber,proto3" json:"CurrentClientNumber,omitempty"`
 	ConfigClientMachineInitial *ConfigClientMachineInitial `protobuf:"bytes,8,opt,name=ConfigClientMachineInitial" json:"ConfigClientMachineInitial,omitempty"`
-	Flag_Etcd_Other            *Flag_Etcd_Other            `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty"`
-	Flag_Etcd_Tip              *Flag_Etcd_Tip              `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
-	Flag_Etcd_V3_2             *Flag_Etcd_V3_2             `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
-	Flag_Etcd_V3_3             *Flag_Etcd_V3_3             `protobuf:"bytes,103,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
-	Flag_Zookeeper_R3_5_3Beta  *Flag_Zookeeper_R3_5_3Beta  `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
-	Flag_Consul_V1_0_2         *Flag_Consul_V1_0_2         `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
-	Flag_Cetcd_Beta            *Flag_Cetcd_Beta            `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
-	Flag_Zetcd_Beta            *Flag_Zetcd_Beta            `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
+	// ConfigClientMachineAgentControl is the part of the benchmark
+	// to run on the load generator, with 'StressPrepare'.
+	ConfigClientMachineAgentControl *ConfigClientMachineAgentControl `protobuf:"bytes,9,opt,name=ConfigClientMachineAgentControl" json:"ConfigClientMachineAgentControl,omitempty"`
+	// LoadGeneratorIndex is the index of the load generator.
+	LoadGeneratorIndex uint32 `protobuf:"varint,10,opt,name=LoadGeneratorIndex,proto3" json:"LoadGeneratorIndex,omitempty"`
+	// RequestOffset is the index of the first sequential key to write,
+	// so that load generators write different keys.
+	RequestOffset int64 `protobuf:"varint,11,opt,name=RequestOffset,proto3" json:"RequestOffset,omitempty"`
+	// StartUnixNano is the time to start the requests, with 'StressStart'.
+	StartUnixNano             int64                      `protobuf:"varint,12,opt,name=StartUnixNano,proto3" json:"StartUnixNano,omitempty"`
+	Flag_Etcd_Other           *Flag_Etcd_Other           `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty"`
+	Flag_Etcd_Tip             *Flag_Etcd_Tip             `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
+	Flag_Etcd_V3_2            *Flag_Etcd_V3_2            `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
+	Flag_Etcd_V3_3            *Flag_Etcd_V3_3            `protobuf:"bytes,103,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
+	Flag_Zookeeper_R3_5_3Beta *Flag_Zookeeper_R3_5_3Beta `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
+	Flag_Consul_V1_0_2        *Flag_Consul_V1_0_2        `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
+	Flag_Cetcd_Beta           *Flag_Cetcd_Beta           `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
+	Flag_Zetcd_Beta           *Flag_Zetcd_Beta           `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
+	Flag_Ytsaurus_Cypress     *Flag_Ytsaurus_Cypress     `protobuf:"bytes,600,opt,name=flag__ytsaurus__cypress,json=flagYtsaurusCypress" json:"flag__ytsaurus__cypress,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -74,6 +103,9 @@ type Response struct {
 	// DiskSpaceUsageBytes is the data size of the database on disk in bytes.
 	// It measures after database is requested to stop.
 	DiskSpaceUsageBytes int64 `protobuf:"varint,2,opt,name=DiskSpaceUsageBytes,proto3" json:"DiskSpaceUsageBytes,omitempty"`
+	// StressStats are the encoded stats of the load generator,
+	// returned with 'StressResult'.
+	StressStats []byte `protobuf:"bytes,3,opt,name=StressStats,proto3" json:"StressStats,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
@@ -226,17 +258,42 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.ConfigClientMachineAgentControl != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigClientMachineAgentControl.Size()))
+		n2, err := m.ConfigClientMachineAgentControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.LoadGeneratorIndex != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.LoadGeneratorIndex))
+	}
+	if m.RequestOffset != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.RequestOffset))
+	}
+	if m.StartUnixNano != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartUnixNano))
+	}
 	if m.Flag_Etcd_Other != nil {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
-		n2, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
+		n3, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.Flag_Etcd_Tip != nil {
 		dAtA[i] = 0xaa
@@ -244,11 +301,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n3, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		n4, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.Flag_Etcd_V3_2 != nil {
 		dAtA[i] = 0xb2
@@ -256,11 +313,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n4, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		n5, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	if m.Flag_Etcd_V3_3 != nil {
 		dAtA[i] = 0xba
@@ -268,11 +325,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n5, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
+		n6, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if m.Flag_Zookeeper_R3_5_3Beta != nil {
 		dAtA[i] = 0xc2
@@ -280,11 +337,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n6, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		n7, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.Flag_Consul_V1_0_2 != nil {
 		dAtA[i] = 0xe2
@@ -292,11 +349,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n7, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		n8, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.Flag_Cetcd_Beta != nil {
 		dAtA[i] = 0x82
@@ -304,11 +361,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x19
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n8, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		n9, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if m.Flag_Zetcd_Beta != nil {
 		dAtA[i] = 0xa2
@@ -316,11 +373,23 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1f
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n9, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		n10, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
+	}
+	if m.Flag_Ytsaurus_Cypress != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x25
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Ytsaurus_Cypress.Size()))
+		n11, err := m.Flag_Ytsaurus_Cypress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
 	return i, nil
 }
@@ -355,6 +424,12 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.DiskSpaceUsageBytes))
 	}
+	if len(m.StressStats) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.StressStats)))
+		i += copy(dAtA[i:], m.StressStats)
+	}
 	return i, nil
 }
 
@@ -397,6 +472,19 @@ func (m *Request) Size() (n int) {
 		l = m.ConfigClientMachineInitial.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.ConfigClientMachineAgentControl != nil {
+		l = m.ConfigClientMachineAgentControl.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LoadGeneratorIndex != 0 {
+		n += 1 + sovMessage(uint64(m.LoadGeneratorIndex))
+	}
+	if m.RequestOffset != 0 {
+		n += 1 + sovMessage(uint64(m.RequestOffset))
+	}
+	if m.StartUnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.StartUnixNano))
+	}
 	if m.Flag_Etcd_Other != nil {
 		l = m.Flag_Etcd_Other.Size()
 		n += 2 + l + sovMessage(uint64(l))
@@ -429,6 +517,10 @@ func (m *Request) Size() (n int) {
 		l = m.Flag_Zetcd_Beta.Size()
 		n += 2 + l + sovMessage(uint64(l))
 	}
+	if m.Flag_Ytsaurus_Cypress != nil {
+		l = m.Flag_Ytsaurus_Cypress.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -441,6 +533,10 @@ func (m *Response) Size() (n int) {
 	if m.DiskSpaceUsageBytes != 0 {
 		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
 	}
+	l = len(m.StressStats)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -673,6 +769,96 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineAgentControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigClientMachineAgentControl == nil {
+				m.ConfigClientMachineAgentControl = &ConfigClientMachineAgentControl{}
+			}
+			if err := m.ConfigClientMachineAgentControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadGeneratorIndex", wireType)
+			}
+			m.LoadGeneratorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoadGeneratorIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestOffset", wireType)
+			}
+			m.RequestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUnixNano", wireType)
+			}
+			m.StartUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartUnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Other", wireType)
@@ -937,6 +1123,39 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 600:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Ytsaurus_Cypress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Ytsaurus_Cypress == nil {
+				m.Flag_Ytsaurus_Cypress = &Flag_Ytsaurus_Cypress{}
+			}
+			if err := m.Flag_Ytsaurus_Cypress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1026,6 +1245,37 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StressStats", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StressStats = append(m.StressStats[:0], dAtA[iNdEx:postIndex]...)
+			if m.StressStats == nil {
+				m.StressStats = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1155,50 +1405,60 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x4e, 0x1b, 0x47,
-	0x14, 0xc6, 0xbd, 0x98, 0x3f, 0xf6, 0x58, 0xa6, 0xee, 0x00, 0xd5, 0xc8, 0x50, 0x77, 0x85, 0x2a,
-	0x64, 0x21, 0xd5, 0x06, 0xaf, 0x68, 0xaf, 0x8b, 0x69, 0x8b, 0xa5, 0x26, 0xa0, 0xb1, 0xe1, 0x82,
-	0x9b, 0xd1, 0xec, 0xfa, 0x78, 0x59, 0x61, 0xef, 0x6c, 0x66, 0xc7, 0x28, 0xe1, 0x2e, 0x6f, 0x90,
-	0xcb, 0x3c, 0x44, 0x1e, 0x84, 0xcb, 0x3c, 0x42, 0x42, 0x5e, 0x21, 0x0f, 0x10, 0xed, 0xac, 0x17,
-	0x0f, 0xd8, 0x4e, 0xee, 0x7c, 0xbe, 0xef, 0x9b, 0xdf, 0xce, 0x1f, 0x9f, 0x83, 0x48, 0xdf, 0x55,
-	0x10, 0x2b, 0x90, 0x91, 0xdb, 0x1c, 0x41, 0x1c, 0x73, 0x1f, 0x1a, 0x91, 0x14, 0x4a, 0x60, 0x34,
-	0x75, 0xaa, 0x7f, 0xf8, 0x81, 0xba, 0x1e, 0xbb, 0x0d, 0x4f, 0x8c, 0x9a, 0xbe, 0xf0, 0x45, 0x53,
-	0x47, 0xdc, 0xf1, 0x40, 0x57, 0xba, 0xd0, 0xbf, 0xd2, 0xa5, 0xd5, 0x1d, 0x03, 0xda, 0xe7, 0x8a,
-	0xbb, 0x3c, 0x06, 0x16, 0xf4, 0x27, 0x6e, 0xd5, 0x70, 0x07, 0x43, 0xee, 0x33, 0x50, 0x5e, 0xe6,
-	0xfd, 0xf6, 0xdc, 0xbb, 0x13, 0xe2, 0x06, 0x20, 0x02, 0x39, 0x07, 0xad, 0x03, 0x9e, 0x08, 0xe3,
-	0xf1, 0x70, 0xe2, 0x6e, 0xcf, 0x2c, 0x37, 0xd8, 0x33, 0xa6, 0x67, 0x98, 0x7b, 0x86, 0xe9, 0x89,
-	0x70, 0x10, 0xf8, 0xcc, 0x1b, 0x06, 0x10, 0x2a, 0x36, 0xe2, 0xde, 0x75, 0x10, 0x4e, 0x6e, 0x65,
-	0xf7, 0x6d, 0x01, 0xad, 0x51, 0x78, 0x35, 0x86, 0x58, 0x61, 0x07, 0x15, 0xcf, 0x22, 0x90, 0x5c,
-	0x05, 0x22, 0x24, 0x96, 0x6d, 0xd5, 0xd7, 0x5b, 0x5b, 0x8d, 0x29, 0xa7, 0xf1, 0x68, 0xd2, 0x69,
-	0x0e, 0xef, 0xa3, 0x4a, 0x4f, 0x06, 0xbe, 0x0f, 0xf2, 0x7f, 0xe1, 0x5f, 0x44, 0x43, 0xc1, 0xfb,
-	0x64, 0xc9, 0xb6, 0xea, 0x05, 0x3a, 0xa3, 0xe3, 0x3f, 0x11, 0x3a, 0x99, 0x5c, 0x5f, 0xe7, 0x84,
-	0xe4, 0xf5, 0x17, 0x7e, 0x31, 0xbf, 0x30, 0x75, 0xa9, 0x91, 0xc4, 0x36, 0x2a, 0x65, 0x55, 0x8f,
-	0xfb, 0x64, 0xd9, 0xb6, 0xea, 0x45, 0x6a, 0x4a, 0xf8, 0x77, 0x54, 0x3e, 0x07, 0x90, 0x9d, 0xf3,
-	0xb8, 0xab, 0x64, 0x10, 0xfa, 0x64, 0x45, 0x67, 0x9e, 0x8a, 0x98, 0xa0, 0xb5, 0xce, 0x79, 0x27,
-	0xec, 0xc3, 0x6b, 0xb2, 0x6a, 0x5b, 0xf5, 0x32, 0xcd, 0x4a, 0x7c, 0x80, 0x36, 0xda, 0x63, 0x29,
-	0x21, 0x54, 0x6d, 0x7d, 0x4b, 0x2f, 0xc7, 0x23, 0x17, 0x24, 0x59, 0xb3, 0xad, 0x7a, 0x9e, 0xce,
-	0xb3, 0xf0, 0x00, 0x55, 0xdb, 0xfa, 0x5e, 0x53, 0xf5, 0x45, 0x7a, 0xab, 0x9d, 0x30, 0x50, 0x01,
-	0x1f, 0x92, 0x82, 0x6d, 0xd5, 0x4b, 0xad, 0x3d, 0xf3, 0x6c, 0x8b, 0xd3, 0xf4, 0x3b, 0x24, 0xfc,
-	0x1f, 0xfa, 0x59, 0x3f, 0xae, 0xfe, 0x57, 0x31, 0x26, 0xd4, 0x35, 0x48, 0xd2, 0xd7, 0xf8, 0x5f,
-	0x4d, 0xfc, 0x4c, 0x88, 0x96, 0x13, 0xe9, 0x1f, 0xe5, 0xf5, 0xcf, 0x92, 0x12, 0xff, 0x8d, 0x7e,
-	0x32, 0x33, 0x2a, 0x88, 0x08, 0x68, 0xcc, 0xf6, 0x22, 0x8c, 0x0a, 0x22, 0x5a, 0xca, 0x20, 0xbd,
-	0x20, 0xc2, 0x6d, 0x54, 0x31, 0xfd, 0x5b, 0x87, 0xb5, 0xc8, 0x40, 0x33, 0x76, 0x16, 0x31, 0x92,
-	0xcc, 0x14, 0x72, 0xe9, 0xb4, 0xe6, 0x40, 0x1c, 0xe2, 0xff, 0x10, 0xe2, 0x98, 0x10, 0x07, 0x0f,
-	0xd0, 0x4e, 0x1a, 0x78, 0xec, 0x27, 0xc6, 0xa4, 0xc3, 0x8e, 0x98, 0xc3, 0x5c, 0x50, 0x9c, 0xdc,
-	0x5b, 0x9a, 0x58, 0x9f, 0x25, 0xce, 0x5f, 0x40, 0xb7, 0x12, 0xf7, 0x2a, 0xf3, 0xa8, 0x73, 0xe4,
-	0x1c, 0x83, 0xe2, 0xf8, 0x0c, 0x6d, 0xa6, 0xcb, 0xd2, 0xb6, 0x64, 0xec, 0xf6, 0x90, 0x1d, 0xb0,
-	0x16, 0xf9, 0xb0, 0xa4, 0xf9, 0xf6, 0x2c, 0xff, 0x69, 0x90, 0xae, 0x27, 0x6a, 0x5b, 0x6b, 0x97,
-	0x87, 0x07, 0x2d, 0x7c, 0x9a, 0x3d, 0xa7, 0x97, 0x1e, 0x4d, 0xef, 0xf6, 0x5d, 0x7e, 0xd1, 0x7b,
-	0x1a, 0xa9, 0xf4, 0x3d, 0xdb, 0x89, 0xa0, 0xb7, 0xf6, 0x48, 0xba, 0x33, 0x48, 0x5f, 0x17, 0x92,
-	0xee, 0x9e, 0x93, 0xae, 0x32, 0xd2, 0xee, 0x25, 0x2a, 0x50, 0x88, 0x23, 0x11, 0xc6, 0x90, 0xb4,
-	0x48, 0x77, 0xec, 0x79, 0x10, 0xc7, 0x7a, 0x02, 0x14, 0x68, 0x56, 0x26, 0x2d, 0x72, 0x12, 0xc4,
-	0x37, 0xdd, 0x88, 0x7b, 0x70, 0x91, 0xcc, 0xd5, 0xe3, 0x37, 0x0a, 0x62, 0xdd, 0xeb, 0x79, 0x3a,
-	0xcf, 0xda, 0x6f, 0x1a, 0xf3, 0x04, 0x17, 0xd1, 0x4a, 0x57, 0x71, 0xa9, 0x2a, 0x39, 0x5c, 0x40,
-	0xcb, 0x5d, 0x25, 0xa2, 0x8a, 0x85, 0xcb, 0xa8, 0x78, 0x0a, 0x5c, 0x2a, 0x17, 0xb8, 0xaa, 0x2c,
-	0xb5, 0xfe, 0x45, 0xa5, 0x9e, 0xe4, 0x61, 0x1c, 0x09, 0xa9, 0x40, 0xe2, 0xbf, 0x50, 0x41, 0x97,
-	0x03, 0x90, 0x78, 0xc3, 0x3c, 0xd1, 0x64, 0x60, 0x55, 0x37, 0x9f, 0x8a, 0xe9, 0x11, 0x76, 0x73,
-	0xc7, 0x9b, 0xf7, 0x9f, 0x6b, 0xb9, 0xfb, 0x87, 0x9a, 0xf5, 0xf1, 0xa1, 0x66, 0x7d, 0x7a, 0xa8,
-	0x59, 0xef, 0xbf, 0xd4, 0x72, 0xee, 0xaa, 0x9e, 0x78, 0xce, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x9b, 0x7a, 0x8c, 0xc2, 0x23, 0x06, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xdf, 0x6e, 0xdb, 0x36,
+	0x14, 0xc6, 0xa3, 0x38, 0x7f, 0x6c, 0x3a, 0x6e, 0x55, 0x26, 0xdd, 0x08, 0x37, 0x73, 0x8d, 0x6c,
+	0x28, 0x8c, 0x0e, 0x73, 0x52, 0x0b, 0xdd, 0xae, 0x1b, 0x67, 0x6b, 0x0d, 0x74, 0x4d, 0x40, 0x27,
+	0x05, 0x96, 0x1b, 0x82, 0x92, 0x8f, 0x14, 0xa1, 0x8e, 0xa8, 0x91, 0x54, 0x97, 0xe4, 0x29, 0x76,
+	0xb9, 0x87, 0xd8, 0x83, 0xe4, 0xb2, 0x97, 0xbb, 0xdc, 0xb2, 0x57, 0xd8, 0x03, 0x0c, 0xa2, 0xec,
+	0x98, 0x8e, 0xed, 0xe6, 0xce, 0xe7, 0xfb, 0x3e, 0xfe, 0x28, 0x1e, 0x5a, 0x47, 0x88, 0x0c, 0x7c,
+	0x0d, 0x4a, 0x83, 0x4c, 0xfd, 0xdd, 0x73, 0x50, 0x8a, 0x47, 0xd0, 0x4e, 0xa5, 0xd0, 0x02, 0xa3,
+	0x89, 0x53, 0xff, 0x2e, 0x8a, 0xf5, 0x59, 0xe6, 0xb7, 0x03, 0x71, 0xbe, 0x1b, 0x89, 0x48, 0xec,
+	0x9a, 0x88, 0x9f, 0x85, 0xa6, 0x32, 0x85, 0xf9, 0x55, 0x2c, 0xad, 0x6f, 0x5b, 0xd0, 0x01, 0xd7,
+	0xdc, 0xe7, 0x0a, 0x58, 0x3c, 0x18, 0xb9, 0x75, 0xcb, 0x0d, 0x87, 0x3c, 0x62, 0xa0, 0x83, 0xb1,
+	0xf7, 0xf4, 0xae, 0x77, 0x25, 0xc4, 0x07, 0x80, 0x14, 0xe4, 0x1c, 0xb4, 0x09, 0x04, 0x22, 0x51,
+	0xd9, 0x70, 0xe4, 0x3e, 0x99, 0x59, 0x6e, 0xb1, 0x67, 0xcc, 0xc0, 0x32, 0x1b, 0x77, 0xcd, 0x4b,
+	0xad, 0x78, 0x26, 0x33, 0x35, 0xf2, 0x9f, 0x59, 0x7e, 0x20, 0x92, 0x30, 0x8e, 0x58, 0x30, 0x8c,
+	0x21, 0xd1, 0xec, 0x9c, 0x07, 0x67, 0x71, 0x32, 0xea, 0xda, 0xce, 0x27, 0x84, 0xd6, 0x29, 0xfc,
+	0x9a, 0x81, 0xd2, 0xd8, 0x43, 0x95, 0xc3, 0x14, 0x24, 0xd7, 0xb1, 0x48, 0x88, 0xd3, 0x74, 0x5a,
+	0x0f, 0x3a, 0x8f, 0xdb, 0x13, 0x4e, 0xfb, 0xd6, 0xa4, 0x93, 0x1c, 0x7e, 0x8e, 0xdc, 0x63, 0x19,
+	0x47, 0x11, 0xc8, 0xb7, 0x22, 0x3a, 0x49, 0x87, 0x82, 0x0f, 0xc8, 0x72, 0xd3, 0x69, 0x95, 0xe9,
+	0x8c, 0x8e, 0xbf, 0x47, 0xe8, 0x60, 0xd4, 0xde, 0xde, 0x01, 0x29, 0x99, 0x1d, 0xbe, 0xb0, 0x77,
+	0x98, 0xb8, 0xd4, 0x4a, 0xe2, 0x26, 0xaa, 0x8e, 0xab, 0x63, 0x1e, 0x91, 0x95, 0xa6, 0xd3, 0xaa,
+	0x50, 0x5b, 0xc2, 0xdf, 0xa0, 0xda, 0x11, 0x80, 0xec, 0x1d, 0xa9, 0xbe, 0x96, 0x71, 0x12, 0x91,
+	0x55, 0x93, 0x99, 0x16, 0x31, 0x41, 0xeb, 0xbd, 0xa3, 0x5e, 0x32, 0x80, 0x0b, 0xb2, 0xd6, 0x74,
+	0x5a, 0x35, 0x3a, 0x2e, 0xf1, 0x1e, 0xda, 0xec, 0x66, 0x52, 0x42, 0xa2, 0xbb, 0xa6, 0x4b, 0xef,
+	0xb2, 0x73, 0x1f, 0x24, 0x59, 0x6f, 0x3a, 0xad, 0x12, 0x9d, 0x67, 0xe1, 0x10, 0xd5, 0xbb, 0xa6,
+	0xaf, 0x85, 0xfa, 0x73, 0xd1, 0xd5, 0x5e, 0x12, 0xeb, 0x98, 0x0f, 0x49, 0xb9, 0xe9, 0xb4, 0xaa,
+	0x9d, 0x67, 0xf6, 0xd9, 0x16, 0xa7, 0xe9, 0x67, 0x48, 0x38, 0x43, 0x4f, 0xe7, 0xb8, 0xaf, 0xa2,
+	0xfc, 0x79, 0x44, 0xa2, 0xa5, 0x18, 0x92, 0x8a, 0xd9, 0xec, 0xdb, 0x7b, 0x36, 0xb3, 0x97, 0xd0,
+	0xfb, 0x98, 0xb8, 0x8d, 0xf0, 0x5b, 0xc1, 0x07, 0xaf, 0x21, 0xc9, 0x2f, 0x5a, 0xc8, 0xa2, 0x6b,
+	0xc8, 0x74, 0x6d, 0x8e, 0x93, 0x5f, 0xc0, 0xe8, 0x6f, 0x74, 0x18, 0x86, 0x0a, 0x34, 0xa9, 0x9a,
+	0xd6, 0x4d, 0x8b, 0x79, 0xaa, 0xaf, 0xb9, 0xd4, 0x27, 0x49, 0x7c, 0xf1, 0x8e, 0x27, 0x82, 0x6c,
+	0x14, 0xa9, 0x29, 0x11, 0xbf, 0x46, 0x8f, 0xcc, 0x5f, 0xda, 0xbc, 0x68, 0x8c, 0x09, 0x7d, 0x06,
+	0x92, 0x0c, 0xcc, 0x21, 0xbf, 0xb2, 0x0f, 0x39, 0x13, 0xa2, 0xb5, 0x5c, 0xfa, 0x51, 0x07, 0x83,
+	0xc3, 0xbc, 0xc4, 0xaf, 0xd0, 0x43, 0x3b, 0xa3, 0xe3, 0x94, 0x80, 0xc1, 0x3c, 0x59, 0x84, 0xd1,
+	0x71, 0x4a, 0xab, 0x63, 0xc8, 0x71, 0x9c, 0xe2, 0x2e, 0x72, 0x6d, 0xff, 0xa3, 0xc7, 0x3a, 0x24,
+	0x34, 0x8c, 0xed, 0x45, 0x8c, 0x3c, 0x33, 0x81, 0xbc, 0xf7, 0x3a, 0x73, 0x20, 0x1e, 0x89, 0xee,
+	0x85, 0x78, 0x36, 0xc4, 0xc3, 0x21, 0xda, 0x2e, 0x02, 0xb7, 0x23, 0x86, 0x31, 0xe9, 0xb1, 0x97,
+	0xcc, 0x63, 0x3e, 0x68, 0x4e, 0xae, 0x1d, 0x43, 0x6c, 0xcd, 0x12, 0xe7, 0x2f, 0xa0, 0x8f, 0x73,
+	0xf7, 0x74, 0xec, 0x51, 0xef, 0xa5, 0xb7, 0x0f, 0x9a, 0xe3, 0x43, 0xb4, 0x55, 0x2c, 0x2b, 0x26,
+	0x15, 0x63, 0x1f, 0x5f, 0xb0, 0x3d, 0xd6, 0x21, 0x7f, 0x2e, 0x1b, 0x7e, 0x73, 0x96, 0x3f, 0x1d,
+	0xa4, 0x0f, 0x72, 0xb5, 0x6b, 0xb4, 0xf7, 0x2f, 0xf6, 0x3a, 0xf8, 0xcd, 0xf8, 0x3a, 0x83, 0xe2,
+	0x68, 0xe6, 0x69, 0x7f, 0x2f, 0x2d, 0xba, 0x4f, 0x2b, 0x55, 0xdc, 0x67, 0x37, 0x17, 0xcc, 0xa3,
+	0xdd, 0x92, 0xae, 0x2c, 0xd2, 0x7f, 0x0b, 0x49, 0x57, 0x77, 0x49, 0xa7, 0xb7, 0xa4, 0x53, 0xf4,
+	0x65, 0x91, 0x19, 0x8f, 0x4d, 0xc6, 0x82, 0xcb, 0x54, 0x82, 0x52, 0xe4, 0xaf, 0x15, 0xc3, 0xfb,
+	0x7a, 0x96, 0x37, 0x93, 0xa5, 0x9b, 0xb9, 0xf1, 0xcb, 0x48, 0xee, 0x16, 0xe2, 0xce, 0x05, 0x2a,
+	0x53, 0x50, 0xa9, 0x48, 0x14, 0xe4, 0x13, 0xa7, 0x9f, 0x05, 0x41, 0xce, 0x75, 0xcc, 0x50, 0x1c,
+	0x97, 0xf9, 0xc4, 0x39, 0x88, 0xd5, 0x87, 0x7e, 0xca, 0x03, 0x38, 0xc9, 0x3f, 0x63, 0xfb, 0x97,
+	0x1a, 0x94, 0x19, 0x9d, 0x25, 0x3a, 0xcf, 0xca, 0xa7, 0x60, 0x5f, 0xe7, 0x3b, 0xf4, 0x35, 0xd7,
+	0xca, 0x8c, 0xcf, 0x0d, 0x6a, 0x4b, 0xcf, 0x7f, 0xb3, 0x06, 0x38, 0xae, 0xa0, 0x55, 0xf3, 0x5a,
+	0xb9, 0x4b, 0xb8, 0x8c, 0x56, 0xfa, 0x5a, 0xa4, 0xae, 0x83, 0x6b, 0xa8, 0xf2, 0x06, 0xb8, 0xd4,
+	0x3e, 0x70, 0xed, 0x2e, 0xe3, 0x47, 0xa8, 0x56, 0xac, 0x3f, 0x92, 0x90, 0x72, 0x09, 0x6e, 0x09,
+	0x3f, 0xb4, 0x76, 0x91, 0xda, 0x5d, 0xc1, 0x2e, 0xda, 0x28, 0x04, 0x0a, 0x2a, 0x1b, 0x6a, 0x77,
+	0x75, 0xa2, 0x74, 0x79, 0x12, 0xc0, 0xd0, 0x5d, 0xeb, 0xfc, 0x84, 0xaa, 0xc7, 0x92, 0x27, 0x2a,
+	0x15, 0x52, 0x83, 0xc4, 0x3f, 0xa0, 0xb2, 0x29, 0x43, 0x90, 0x78, 0xd3, 0xee, 0xe3, 0x68, 0x1a,
+	0xd4, 0xb7, 0xa6, 0xc5, 0xa2, 0x59, 0x3b, 0x4b, 0xfb, 0x5b, 0xd7, 0xff, 0x34, 0x96, 0xae, 0x6f,
+	0x1a, 0xce, 0xa7, 0x9b, 0x86, 0xf3, 0xf7, 0x4d, 0xc3, 0xf9, 0xe3, 0xdf, 0xc6, 0x92, 0xbf, 0x66,
+	0x3e, 0x55, 0xde, 0xff, 0x03, 0x00, 0xc5, 0x30, 0xde, 0x44, 0xfc, 0x07, 0x00, 0x00,
 }
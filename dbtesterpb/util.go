@@ -55,6 +55,8 @@ func GetRGBI(databaseID string, i int) color.Color {
 		return color.RGBA{251, 206, 0, 255} // yellow
 	case "cetcd__beta":
 		return color.RGBA{205, 220, 57, 255} // lime
+	case "ytsaurus_cypress":
+		return color.RGBA{255, 152, 0, 255} // orange
 	}
 	return plotutil.Color(i)
 }
@@ -77,6 +79,8 @@ func GetRGBII(databaseID string, i int) color.Color {
 		return color.RGBA{245, 247, 166, 255} // light-yellow
 	case "cetcd__beta":
 		return color.RGBA{238, 255, 65, 255} // light-lime
+	case "ytsaurus_cypress":
+		return color.RGBA{255, 204, 128, 255} // light-orange
 	}
 	return plotutil.Color(i)
 }
@@ -99,6 +103,8 @@ func GetRGBIII(databaseID string, i int) color.Color {
 		return color.RGBA{229, 255, 0, 255} // deep-yellow
 	case "cetcd__beta":
 		return color.RGBA{205, 220, 57, 255} // deep-lime
+	case "ytsaurus_cypress":
+		return color.RGBA{230, 81, 0, 255} // deep-orange
 	}
 	return plotutil.Color(i)
 }
@@ -0,0 +1,184 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"unicode"
+
+	"golang.org/x/net/context"
+)
+
+// Error categories of failed requests in the reports,
+// instead of the raw error messages.
+const (
+	ErrorTimeout         = "timeout"
+	ErrorUnavailable     = "unavailable"
+	ErrorNoLeader        = "no-leader"
+	ErrorConflict        = "conflict"
+	ErrorQuotaExceeded   = "quota-exceeded"
+	ErrorNotFound        = "not-found"
+	ErrorConnectionReset = "connection-reset"
+	ErrorOther           = "other"
+)
+
+// errorSamplesPerCategory is the number of distinct raw messages
+// to keep for each error category.
+const errorSamplesPerCategory = 5
+
+// ErrorClassifier is implemented by the drivers that recognize
+// the errors of their database clients.
+type ErrorClassifier interface {
+	// ClassifyError returns the error category (e.g. ErrorNoLeader),
+	// or "" to fall back to the database-neutral classification.
+	ClassifyError(err error) string
+}
+
+// categorizedError is the request error with its category.
+type categorizedError struct {
+	category string
+	err      error
+}
+
+func (e *categorizedError) Error() string { return e.err.Error() }
+
+func (e *categorizedError) Unwrap() error { return e.err }
+
+// categorizeError returns the error with its category,
+// classified by the driver if supported.
+func categorizeError(drv Driver, err error) error {
+	if err == nil {
+		return nil
+	}
+	var ce *categorizedError
+	if errors.As(err, &ce) {
+		return err
+	}
+	category := ""
+	if c, ok := drv.(ErrorClassifier); ok {
+		category = c.ClassifyError(err)
+	}
+	if category == "" {
+		category = classifyError(err)
+	}
+	return &categorizedError{category: category, err: err}
+}
+
+// errorCategory returns the category of the request error.
+func errorCategory(err error) string {
+	var ce *categorizedError
+	if errors.As(err, &ce) {
+		return ce.category
+	}
+	return classifyError(err)
+}
+
+// classifyError classifies the errors of any database,
+// by the Go errors and then by the message.
+func classifyError(err error) string {
+	if err == errRequestTimeout || err == context.DeadlineExceeded {
+		return ErrorTimeout
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrorConnectionReset
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return ErrorUnavailable
+	}
+	var nerr net.Error
+	if errors.As(err, &nerr) && nerr.Timeout() {
+		return ErrorTimeout
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "no leader") || strings.Contains(msg, "leader unavailable"):
+		return ErrorNoLeader
+	case strings.Contains(msg, "timeout") || strings.Contains(msg, "timed out") || strings.Contains(msg, "deadline exceeded"):
+		return ErrorTimeout
+	case strings.Contains(msg, "connection reset") || strings.Contains(msg, "broken pipe") || containsWord(msg, "eof"):
+		return ErrorConnectionReset
+	case strings.Contains(msg, "unavailable") || strings.Contains(msg, "connection refused") || strings.Contains(msg, "connection closed"):
+		return ErrorUnavailable
+	case strings.Contains(msg, "quota") || strings.Contains(msg, "space exceeded") || strings.Contains(msg, "too many requests") || strings.Contains(msg, "rate limit"):
+		return ErrorQuotaExceeded
+	case strings.Contains(msg, "conflict"):
+		return ErrorConflict
+	case strings.Contains(msg, "not found") || strings.Contains(msg, "does not exist"):
+		return ErrorNotFound
+	}
+	return ErrorOther
+}
+
+// containsWord returns true if the word is in the message,
+// not as part of a longer word (e.g. "eof" but not "thereof").
+func containsWord(msg, word string) bool {
+	fs := strings.FieldsFunc(msg, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, f := range fs {
+		if f == word {
+			return true
+		}
+	}
+	return false
+}
+
+// errorSamples are the distinct raw messages of each error category.
+type errorSamples map[string][]string
+
+// add keeps the message, up to errorSamplesPerCategory per category.
+func (es errorSamples) add(category, msg string) {
+	ms := es[category]
+	if len(ms) >= errorSamplesPerCategory {
+		return
+	}
+	for _, m := range ms {
+		if m == msg {
+			return
+		}
+	}
+	es[category] = append(ms, msg)
+}
+
+func (es errorSamples) merge(other errorSamples) {
+	for category, ms := range other {
+		for _, m := range ms {
+			es.add(category, m)
+		}
+	}
+}
+
+func (es errorSamples) categories() []string {
+	cs := make([]string, 0, len(es))
+	for category := range es {
+		cs = append(cs, category)
+	}
+	sort.Strings(cs)
+	return cs
+}
+
+// ErrorSamplesPath returns the path of the sample error messages
+// of each category, next to the latency summary file.
+func ErrorSamplesPath(summaryPath string) string {
+	ext := filepath.Ext(summaryPath)
+	return strings.TrimSuffix(summaryPath, ext) + "-error-samples.csv"
+}
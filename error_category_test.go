@@ -0,0 +1,87 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_categorizeError(t *testing.T) {
+	tests := []struct {
+		drv      Driver
+		err      error
+		category string
+	}{
+		{etcdv3Driver{}, status.Error(codes.Unavailable, "etcdserver: no leader"), ErrorNoLeader},
+		{etcdv3Driver{}, status.Error(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded"), ErrorQuotaExceeded},
+		{etcdv3Driver{}, status.Error(codes.Unavailable, "transport is closing"), ErrorUnavailable},
+		{etcdv3Driver{}, status.Error(codes.DeadlineExceeded, "context deadline exceeded"), ErrorTimeout},
+		{zkDriver{}, zk.ErrNoNode, ErrorNotFound},
+		{zkDriver{}, zk.ErrBadVersion, ErrorConflict},
+		{zkDriver{}, zk.ErrConnectionClosed, ErrorConnectionReset},
+		{consulDriver{}, errors.New("Unexpected response code: 500 (No cluster leader)"), ErrorNoLeader},
+		{consulDriver{}, errors.New("Unexpected response code: 429 (Your IP is issuing too many concurrent connections)"), ErrorQuotaExceeded},
+
+		// database-neutral
+		{zkDriver{}, errRequestTimeout, ErrorTimeout},
+		{zkDriver{}, context.DeadlineExceeded, ErrorTimeout},
+		{consulDriver{}, fmt.Errorf("put failed (%w)", syscall.ECONNRESET), ErrorConnectionReset},
+		{consulDriver{}, fmt.Errorf("dial tcp 10.0.0.1:8500: %w", syscall.ECONNREFUSED), ErrorUnavailable},
+		{consulDriver{}, errors.New("Put http://10.0.0.1:8500/v1/kv/foo: EOF"), ErrorConnectionReset},
+		{consulDriver{}, errors.New("the session thereof is invalid"), ErrorOther},
+		{etcdv3Driver{}, errNotSupported, ErrorOther},
+	}
+	for i, tt := range tests {
+		err := categorizeError(tt.drv, tt.err)
+		if c := errorCategory(err); c != tt.category {
+			t.Fatalf("#%d: %q expected %q, got %q", i, tt.err, tt.category, c)
+		}
+		if err.Error() != tt.err.Error() {
+			t.Fatalf("#%d: expected raw message %q, got %q", i, tt.err, err)
+		}
+	}
+	if categorizeError(zkDriver{}, nil) != nil {
+		t.Fatal("expected nil error")
+	}
+}
+
+func Test_errorSamples(t *testing.T) {
+	es := make(errorSamples)
+	for i := 0; i < 2*errorSamplesPerCategory; i++ {
+		es.add(ErrorTimeout, fmt.Sprintf("timeout %d", i))
+		es.add(ErrorTimeout, "timeout 0")
+	}
+	es.add(ErrorNotFound, "not found")
+	if len(es[ErrorTimeout]) != errorSamplesPerCategory || es[ErrorTimeout][1] != "timeout 1" {
+		t.Fatalf("expected %d distinct samples, got %v", errorSamplesPerCategory, es[ErrorTimeout])
+	}
+
+	other := errorSamples{ErrorNotFound: {"not found", "no such key"}}
+	es.merge(other)
+	if len(es[ErrorNotFound]) != 2 {
+		t.Fatalf("expected 2 merged samples, got %v", es[ErrorNotFound])
+	}
+	if cs := es.categories(); len(cs) != 2 || cs[0] != ErrorNotFound {
+		t.Fatalf("expected sorted categories, got %v", cs)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/linearizability"
+
+	"golang.org/x/net/context"
+)
+
+// HistoryPath returns the path of the operation history of "register"
+// workload, next to the latency summary file.
+func HistoryPath(summaryPath string) string {
+	ext := filepath.Ext(summaryPath)
+	return strings.TrimSuffix(summaryPath, ext) + "-history.jsonl"
+}
+
+// registerInitialValue is written to all registers before the run,
+// different from the values "1", "2", ... written in the run.
+const registerInitialValue = "0"
+
+// initRegisters writes the initial value to all registers of the key
+// space, and records the puts as the first operations of the history.
+// Otherwise, the checker assumes the registers empty before the run,
+// while the keys may be left by the previous runs.
+func (cfg *Config) initRegisters(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, h *historyRecorder) error {
+	clients, closeConns, err := drv.Connect(gcfg, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		Overwrite:    true,
+	})
+	if err != nil {
+		return err
+	}
+	defer closeConns()
+
+	n := gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize
+	idxc := make(chan int64, len(clients))
+	errc := make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(len(clients))
+	for i := range clients {
+		go func(clientID int, c Client) {
+			defer wg.Done()
+			for idx := range idxc {
+				req := Request{
+					Op:    OpPut,
+					Key:   sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, idx),
+					Value: []byte(registerInitialValue),
+				}
+				st := time.Now()
+				err := c.Put(context.Background(), req.Key, req.Value)
+				h.record(clientID, req, st, time.Now(), err)
+				if err != nil {
+					select {
+					case errc <- fmt.Errorf("register init error [request: PUT | key: %q | database: %q] (%v)", req.Key, gcfg.DatabaseID, err):
+					default:
+					}
+				}
+			}
+		}(i, clients[i])
+	}
+	for i := int64(0); i < n; i++ {
+		idxc <- i
+	}
+	close(idxc)
+	wg.Wait()
+
+	select {
+	case err = <-errc:
+		return err
+	default:
+	}
+	cfg.lg.Sugar().Infof("initialized %d registers to %q [database: %q]", n, registerInitialValue, gcfg.DatabaseID)
+	return nil
+}
+
+// historyRecorder records the register operations of each client,
+// to check the linearizability offline (see "analyze linearizability").
+type historyRecorder struct {
+	mu  sync.Mutex
+	ops []linearizability.Operation
+}
+
+// record records the request of the client. The failed put may or may
+// not take effect, while the failed get tells nothing, unless the key
+// is not found. It is a no-op on nil recorder.
+func (h *historyRecorder) record(clientID int, req Request, start, end time.Time, err error) {
+	if h == nil {
+		return
+	}
+	op := linearizability.Operation{
+		ClientID: int64(clientID),
+		Key:      req.Key,
+		Call:     start.UnixNano(),
+		Return:   end.UnixNano(),
+	}
+	switch req.Op {
+	case OpPut:
+		op.Kind = linearizability.Put
+		op.Value = string(req.Value)
+		op.Unknown = err != nil
+	case OpGet:
+		if err != nil && errorCategory(err) != ErrorNotFound {
+			return
+		}
+		op.Kind = linearizability.Get
+		op.Value = string(req.ReadValue)
+	default:
+		return
+	}
+	h.mu.Lock()
+	h.ops = append(h.ops, op)
+	h.mu.Unlock()
+}
+
+// saveHistory saves the operations in the order of the calls.
+func (cfg *Config) saveHistory(h *historyRecorder) {
+	h.mu.Lock()
+	ops := append([]linearizability.Operation(nil), h.ops...)
+	h.mu.Unlock()
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].Call < ops[j].Call })
+
+	fpath := HistoryPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
+	f, err := os.Create(fpath)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	if err = linearizability.WriteHistory(f, ops); err != nil {
+		panic(err)
+	}
+	cfg.lg.Sugar().Infof("saved history of %d operations to %q", len(ops), fpath)
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/linearizability"
+
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+func Test_historyRecorder(t *testing.T) {
+	start := time.Unix(1000, 0)
+	end := start.Add(time.Millisecond)
+
+	var nilRecorder *historyRecorder
+	nilRecorder.record(0, Request{Op: OpPut, Key: "a", Value: []byte("1")}, start, end, nil)
+
+	h := &historyRecorder{}
+	h.record(0, Request{Op: OpPut, Key: "a", Value: []byte("1")}, start, end, nil)
+	h.record(1, Request{Op: OpPut, Key: "a", Value: []byte("2")}, start, end, errRequestTimeout)
+	h.record(2, Request{Op: OpGet, Key: "a", ReadValue: []byte("1")}, start, end, nil)
+	h.record(3, Request{Op: OpGet, Key: "b"}, start, end, categorizeError(zkDriver{}, zk.ErrNoNode))
+	// the failed get tells nothing about the value
+	h.record(4, Request{Op: OpGet, Key: "a"}, start, end, errors.New("unavailable"))
+	h.record(5, Request{Op: OpDelete, Key: "a"}, start, end, nil)
+
+	call, ret := start.UnixNano(), end.UnixNano()
+	expected := []linearizability.Operation{
+		{ClientID: 0, Kind: linearizability.Put, Key: "a", Value: "1", Call: call, Return: ret},
+		{ClientID: 1, Kind: linearizability.Put, Key: "a", Value: "2", Call: call, Return: ret, Unknown: true},
+		{ClientID: 2, Kind: linearizability.Get, Key: "a", Value: "1", Call: call, Return: ret},
+		{ClientID: 3, Kind: linearizability.Get, Key: "b", Value: "", Call: call, Return: ret},
+	}
+	if !reflect.DeepEqual(h.ops, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, h.ops)
+	}
+}
+
+// memPutDriver connects to the in-memory key-values.
+type memPutDriver struct {
+	Driver
+	mu  *sync.Mutex
+	kvs map[string]string
+}
+
+func (d memPutDriver) Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) ([]Client, func(), error) {
+	cs := make([]Client, ccfg.TotalClients)
+	for i := range cs {
+		cs[i] = memPutClient{d: d}
+	}
+	return cs, func() {}, nil
+}
+
+type memPutClient struct {
+	Client
+	d memPutDriver
+}
+
+func (c memPutClient) Put(ctx context.Context, key string, value []byte) error {
+	c.d.mu.Lock()
+	c.d.kvs[key] = string(value)
+	c.d.mu.Unlock()
+	return nil
+}
+
+func Test_initRegisters(t *testing.T) {
+	cfg := &Config{lg: zap.NewNop()}
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "etcd__tip",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			ClientNumber: 2,
+			KeySizeBytes: 8,
+			KeySpaceSize: 3,
+		},
+	}
+	// left by the previous run
+	drv := memPutDriver{mu: &sync.Mutex{}, kvs: map[string]string{sequentialKey(8, 1): "7"}}
+	h := &historyRecorder{}
+	if err := cfg.initRegisters(drv, gcfg, h); err != nil {
+		t.Fatal(err)
+	}
+	if len(drv.kvs) != 3 || len(h.ops) != 3 {
+		t.Fatalf("expected 3 registers initialized, got %v and %d operations", drv.kvs, len(h.ops))
+	}
+	for _, op := range h.ops {
+		if op.Kind != linearizability.Put || op.Value != registerInitialValue || drv.kvs[op.Key] != registerInitialValue {
+			t.Fatalf("expected the initial put, got %s (%q)", op, drv.kvs[op.Key])
+		}
+	}
+}
+
+func TestHistoryPath(t *testing.T) {
+	if p := HistoryPath("a/etcd-client-latency-distribution-summary.csv"); p != "a/etcd-client-latency-distribution-summary-history.jsonl" {
+		t.Fatalf("unexpected path %q", p)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"math"
+	mrand "math/rand"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+const (
+	// keyDistributionSequential accesses the keys one by one.
+	keyDistributionSequential = "sequential"
+	// keyDistributionUniform accesses random keys with equal probability.
+	keyDistributionUniform = "uniform"
+	// keyDistributionZipfian accesses the keys with lower indices more often.
+	keyDistributionZipfian = "zipfian"
+	// keyDistributionHotspot sends 'HotspotRequestPercent' of requests
+	// to 'HotspotKeyPercent' of keys.
+	keyDistributionHotspot = "hotspot"
+	// keyDistributionLatest accesses the most recently written keys
+	// more often, as YCSB "latest" distribution.
+	keyDistributionLatest = "latest"
+
+	defaultZipfianSkew           = 0.99
+	defaultHotspotKeyPercent     = 20
+	defaultHotspotRequestPercent = 80
+)
+
+func isValidKeyDistribution(dist string) bool {
+	switch dist {
+	case keyDistributionSequential,
+		keyDistributionUniform,
+		keyDistributionZipfian,
+		keyDistributionHotspot,
+		keyDistributionLatest:
+		return true
+	}
+	return false
+}
+
+// keyDistributionName returns the configured distribution,
+// or 'defaultDist' if not configured.
+func keyDistributionName(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, defaultDist string) string {
+	if opts.KeyDistribution == "" {
+		return defaultDist
+	}
+	return opts.KeyDistribution
+}
+
+// insertsKeys returns true if "write" workload creates new keys
+// with the distribution, instead of updating the preloaded keys.
+func insertsKeys(dist string) bool {
+	return dist == "" || dist == keyDistributionSequential || dist == keyDistributionLatest
+}
+
+// keyIndexGenerator picks key indices out of the key space.
+type keyIndexGenerator interface {
+	// next returns the index of the next key to access.
+	next() int64
+}
+
+// newKeyIndexGenerator returns the generator of the key distribution
+// over 'KeySpaceSize' keys, or of 'defaultDist' if not configured.
+func newKeyIndexGenerator(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, defaultDist string, rnd *mrand.Rand) (keyIndexGenerator, error) {
+	dist := keyDistributionName(opts, defaultDist)
+	n := opts.KeySpaceSize
+	if n <= 0 {
+		return nil, fmt.Errorf("%q distribution got invalid key space size %d", dist, n)
+	}
+
+	switch dist {
+	case keyDistributionSequential:
+		return &sequentialGenerator{n: n}, nil
+	case keyDistributionUniform:
+		return &uniformGenerator{rnd: rnd, n: n}, nil
+	case keyDistributionZipfian:
+		return newZipfianGenerator(rnd, n, opts.ZipfianSkew), nil
+	case keyDistributionHotspot:
+		hotN := n * opts.HotspotKeyPercent / 100
+		if hotN < 1 {
+			hotN = 1
+		}
+		return &hotspotGenerator{rnd: rnd, n: n, hotN: hotN, hotPercent: opts.HotspotRequestPercent}, nil
+	case keyDistributionLatest:
+		return &latestGenerator{zipf: newZipfianGenerator(rnd, n, opts.ZipfianSkew), last: n - 1}, nil
+	}
+	return nil, fmt.Errorf("unknown key distribution %q", dist)
+}
+
+type sequentialGenerator struct {
+	n   int64
+	cur int64
+}
+
+func (g *sequentialGenerator) next() int64 {
+	idx := g.cur % g.n
+	g.cur++
+	return idx
+}
+
+type uniformGenerator struct {
+	rnd *mrand.Rand
+	n   int64
+}
+
+func (g *uniformGenerator) next() int64 {
+	return g.rnd.Int63n(g.n)
+}
+
+// zipfianGenerator is the zipfian generator of YCSB, from
+// "Quickly Generating Billion-Record Synthetic Databases" by Gray et al.,
+// which supports skews below 1 unlike math/rand.Zipf.
+type zipfianGenerator struct {
+	rnd   *mrand.Rand
+	n     int64
+	theta float64
+	alpha float64
+	zetan float64
+	eta   float64
+}
+
+func newZipfianGenerator(rnd *mrand.Rand, n int64, theta float64) *zipfianGenerator {
+	zetan := zeta(n, theta)
+	return &zipfianGenerator{
+		rnd:   rnd,
+		n:     n,
+		theta: theta,
+		alpha: 1 / (1 - theta),
+		zetan: zetan,
+		eta:   (1 - math.Pow(2/float64(n), 1-theta)) / (1 - zeta(2, theta)/zetan),
+	}
+}
+
+func zeta(n int64, theta float64) float64 {
+	var sum float64
+	for i := int64(1); i <= n; i++ {
+		sum += 1 / math.Pow(float64(i), theta)
+	}
+	return sum
+}
+
+func (g *zipfianGenerator) next() int64 {
+	if g.n < 2 {
+		return 0
+	}
+	u := g.rnd.Float64()
+	uz := u * g.zetan
+	if uz < 1 {
+		return 0
+	}
+	if uz < 1+math.Pow(0.5, g.theta) {
+		return 1
+	}
+	idx := int64(float64(g.n) * math.Pow(g.eta*u-g.eta+1, g.alpha))
+	if idx >= g.n {
+		idx = g.n - 1
+	}
+	return idx
+}
+
+type hotspotGenerator struct {
+	rnd        *mrand.Rand
+	n          int64
+	hotN       int64
+	hotPercent int64
+}
+
+func (g *hotspotGenerator) next() int64 {
+	if g.hotN >= g.n || g.rnd.Int63n(100) < g.hotPercent {
+		return g.rnd.Int63n(g.hotN)
+	}
+	return g.hotN + g.rnd.Int63n(g.n-g.hotN)
+}
+
+// latestGenerator accesses the keys zipfian-distributed
+// by how recently they are written.
+type latestGenerator struct {
+	zipf *zipfianGenerator
+	// last is the index of the last written key.
+	last int64
+}
+
+func (g *latestGenerator) next() int64 {
+	idx := g.last - g.zipf.next()
+	if idx < 0 {
+		idx = 0
+	}
+	return idx
+}
+
+// insert returns the index of a new key to write,
+// which becomes the latest key.
+func (g *latestGenerator) insert() int64 {
+	g.last++
+	return g.last
+}
@@ -0,0 +1,99 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	mrand "math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func Test_keyIndexGenerator(t *testing.T) {
+	const n, total = 100, 100000
+	tests := []struct {
+		opts dbtesterpb.ConfigClientMachineBenchmarkOptions
+		// min and max number of accesses to the first 20 keys
+		minHead, maxHead int
+	}{
+		{
+			opts:    dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyDistribution: keyDistributionSequential},
+			minHead: total / 5, maxHead: total / 5,
+		},
+		{
+			opts:    dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyDistribution: keyDistributionUniform},
+			minHead: total * 18 / 100, maxHead: total * 22 / 100,
+		},
+		{
+			opts:    dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyDistribution: keyDistributionZipfian, ZipfianSkew: defaultZipfianSkew},
+			minHead: total * 60 / 100, maxHead: total,
+		},
+		{
+			opts:    dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyDistribution: keyDistributionHotspot, HotspotKeyPercent: 20, HotspotRequestPercent: 80},
+			minHead: total * 78 / 100, maxHead: total * 82 / 100,
+		},
+		{
+			// the latest keys are the last ones
+			opts:    dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyDistribution: keyDistributionLatest, ZipfianSkew: defaultZipfianSkew},
+			minHead: 0, maxHead: total * 20 / 100,
+		},
+	}
+	for i, tt := range tests {
+		tt.opts.KeySpaceSize = n
+		g, err := newKeyIndexGenerator(&tt.opts, "", mrand.New(mrand.NewSource(1)))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		head := 0
+		for j := 0; j < total; j++ {
+			idx := g.next()
+			if idx < 0 || idx >= n {
+				t.Fatalf("#%d: index %d is out of key space", i, idx)
+			}
+			if idx < 20 {
+				head++
+			}
+		}
+		if head < tt.minHead || head > tt.maxHead {
+			t.Fatalf("#%d(%s): expected %d to %d accesses to the first 20 keys, got %d", i, tt.opts.KeyDistribution, tt.minHead, tt.maxHead, head)
+		}
+	}
+}
+
+func Test_keyIndexGeneratorSeed(t *testing.T) {
+	opts := &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+		KeySpaceSize:    1000,
+		KeyDistribution: keyDistributionZipfian,
+		ZipfianSkew:     0.5,
+	}
+	sample := func(seed int64) []int64 {
+		g, err := newKeyIndexGenerator(opts, "", mrand.New(mrand.NewSource(seed)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		idxs := make([]int64, 100)
+		for i := range idxs {
+			idxs[i] = g.next()
+		}
+		return idxs
+	}
+	if !reflect.DeepEqual(sample(7), sample(7)) {
+		t.Fatal("expected the same keys with the same seed")
+	}
+	if reflect.DeepEqual(sample(7), sample(8)) {
+		t.Fatal("expected different keys with different seeds")
+	}
+}
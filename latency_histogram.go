@@ -0,0 +1,580 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+	"github.com/etcd-io/etcd/pkg/report"
+)
+
+const (
+	// latencyHistogramHighest is the highest latency in histograms,
+	// slower requests are recorded as this.
+	latencyHistogramHighest = time.Hour
+	// latencyHistogramSigFigs keeps the percentiles of all requests
+	// within 0.1%, in about 190 KB.
+	latencyHistogramSigFigs = 3
+	// latencyPerSecondSigFigs keeps the percentiles of each second
+	// within about 6%, in about 4 KB per second. The minimum, average
+	// and maximum of each second are exact.
+	latencyPerSecondSigFigs = 1
+)
+
+// latencyPercentiles are the percentiles to save.
+var latencyPercentiles = []float64{10, 25, 50, 75, 90, 95, 99, 99.9, 99.99, 99.999}
+
+// percentileName returns the name of the percentile (e.g. "p99.99").
+func percentileName(pct float64) string {
+	return "p" + strconv.FormatFloat(pct, 'f', -1, 64)
+}
+
+// latencyHistogram records latencies in microseconds in the HDR histogram,
+// with the exact count, minimum, maximum, mean and standard deviation.
+// It is not safe for concurrent use.
+type latencyHistogram struct {
+	h *hdrhistogram.Histogram
+
+	min   int64
+	max   int64
+	sum   float64
+	sumSq float64
+}
+
+func newLatencyHistogram(sigFigs int) *latencyHistogram {
+	return &latencyHistogram{h: hdrhistogram.New(1, int64(latencyHistogramHighest/time.Microsecond), sigFigs)}
+}
+
+// Record records a latency in microseconds. Values above
+// 'latencyHistogramHighest' are recorded as the highest.
+func (lh *latencyHistogram) Record(v int64) {
+	if v < 0 {
+		v = 0
+	}
+	if highest := lh.h.HighestTrackableValue(); v > highest {
+		v = highest
+	}
+	if lh.h.TotalCount() == 0 || v < lh.min {
+		lh.min = v
+	}
+	if v > lh.max {
+		lh.max = v
+	}
+	lh.sum += float64(v)
+	lh.sumSq += float64(v) * float64(v)
+	lh.h.RecordValue(v)
+}
+
+// Merge adds all values of 'other'.
+func (lh *latencyHistogram) Merge(other *latencyHistogram) {
+	if other.h.TotalCount() == 0 {
+		return
+	}
+	if lh.h.TotalCount() == 0 || other.min < lh.min {
+		lh.min = other.min
+	}
+	if other.max > lh.max {
+		lh.max = other.max
+	}
+	lh.sum += other.sum
+	lh.sumSq += other.sumSq
+	lh.h.Merge(other.h)
+}
+
+// TotalCount returns the number of recorded values.
+func (lh *latencyHistogram) TotalCount() int64 { return lh.h.TotalCount() }
+
+// Min returns the smallest recorded value, or 0 if empty.
+func (lh *latencyHistogram) Min() int64 { return lh.min }
+
+// Max returns the largest recorded value, or 0 if empty.
+func (lh *latencyHistogram) Max() int64 { return lh.max }
+
+// Sum returns the sum of all recorded values.
+func (lh *latencyHistogram) Sum() float64 { return lh.sum }
+
+// Mean returns the mean of recorded values, or 0 if empty.
+func (lh *latencyHistogram) Mean() float64 {
+	if lh.h.TotalCount() == 0 {
+		return 0
+	}
+	return lh.sum / float64(lh.h.TotalCount())
+}
+
+// StdDev returns the population standard deviation of recorded values.
+func (lh *latencyHistogram) StdDev() float64 {
+	n := lh.h.TotalCount()
+	if n == 0 {
+		return 0
+	}
+	mean := lh.Mean()
+	variance := lh.sumSq/float64(n) - mean*mean
+	if variance < 0 { // rounding errors
+		return 0
+	}
+	return math.Sqrt(variance)
+}
+
+// ValueAtPercentile returns the value that 'pct' percent of recorded
+// values are less than or equal to, within the histogram precision.
+// It is at most the exact maximum, while the histogram returns the
+// highest value of the bucket.
+func (lh *latencyHistogram) ValueAtPercentile(pct float64) int64 {
+	v := lh.h.ValueAtPercentile(pct)
+	if v > lh.max {
+		return lh.max
+	}
+	return v
+}
+
+// Buckets returns the non-empty buckets in increasing order.
+func (lh *latencyHistogram) Buckets() []hdrhistogram.Bar {
+	var bs []hdrhistogram.Bar
+	for _, b := range lh.h.Distribution() {
+		if b.Count > 0 {
+			bs = append(bs, b)
+		}
+	}
+	return bs
+}
+
+// encodedLatencyHistogram is the latency histogram in JSON, with the
+// histogram in the compressed HdrHistogram V2 encoding, which is
+// read by the other HdrHistogram implementations.
+type encodedLatencyHistogram struct {
+	Histogram  []byte  `json:"histogram"`
+	Min        int64   `json:"min"`
+	Max        int64   `json:"max"`
+	Sum        float64 `json:"sum"`
+	SumSquares float64 `json:"sum_squares"`
+}
+
+// Encode writes the histogram in JSON, to be read by decodeLatencyHistogram.
+func (lh *latencyHistogram) Encode(w io.Writer) error {
+	b, err := lh.h.Encode(hdrhistogram.V2CompressedEncodingCookieBase)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(encodedLatencyHistogram{
+		Histogram:  b,
+		Min:        lh.min,
+		Max:        lh.max,
+		Sum:        lh.sum,
+		SumSquares: lh.sumSq,
+	})
+}
+
+func decodeLatencyHistogram(r io.Reader) (*latencyHistogram, error) {
+	var e encodedLatencyHistogram
+	if err := json.NewDecoder(r).Decode(&e); err != nil {
+		return nil, err
+	}
+	h, err := hdrhistogram.Decode(e.Histogram)
+	if err != nil {
+		return nil, err
+	}
+	return &latencyHistogram{h: h, min: e.Min, max: e.Max, sum: e.Sum, sumSq: e.SumSquares}, nil
+}
+
+// latencyStats are the stats of requests with latencies
+// recorded in histograms of microseconds. 'Lats' is always
+// empty, so that the memory does not grow with requests.
+type latencyStats struct {
+	report.Stats
+
+	// hist is the latency of all successful requests.
+	hist *latencyHistogram
+	// perSecond is the latency of successful requests
+	// started at each unix second, including warm-up and cool-down.
+	perSecond map[int64]*latencyHistogram
+	// errorsPerSecond is the number of failed requests
+	// started at each unix second, including warm-up and cool-down.
+	errorsPerSecond map[int64]int64
+	// errorSamples are the raw messages of the error
+	// categories in 'ErrorDist'.
+	errorSamples errorSamples
+
+	// warmup and cooldown are the number of requests
+	// excluded from the stats in each phase.
+	warmup, cooldown int64
+	// phases are the phases of the unix seconds
+	// with any excluded request.
+	phases map[int64]string
+	// start and end are the first start and the last end
+	// of the requests in the stats.
+	start, end time.Time
+	// begin is when the requests began to be sent, including
+	// the warm-up, from which the load profile is timed.
+	begin time.Time
+}
+
+func newLatencyStats() latencyStats {
+	return latencyStats{
+		Stats:           report.Stats{ErrorDist: make(map[string]int)},
+		hist:            newLatencyHistogram(latencyHistogramSigFigs),
+		perSecond:       make(map[int64]*latencyHistogram),
+		errorsPerSecond: make(map[int64]int64),
+		errorSamples:    make(errorSamples),
+		phases:          make(map[int64]string),
+	}
+}
+
+// record records the request in the stats.
+func (st *latencyStats) record(res report.Result) {
+	if st.start.IsZero() || res.Start.Before(st.start) {
+		st.start = res.Start
+	}
+	if res.End.After(st.end) {
+		st.end = res.End
+	}
+	if res.Err != nil {
+		category := errorCategory(res.Err)
+		st.ErrorDist[category]++
+		st.errorSamples.add(category, res.Err.Error())
+		return
+	}
+	st.hist.Record(int64(res.Duration() / time.Microsecond))
+}
+
+// recordSecond records the request in the time series.
+func (st *latencyStats) recordSecond(res report.Result) {
+	if res.Err != nil {
+		st.errorsPerSecond[res.Start.Unix()]++
+		return
+	}
+	h, ok := st.perSecond[res.Start.Unix()]
+	if !ok {
+		h = newLatencyHistogram(latencyPerSecondSigFigs)
+		st.perSecond[res.Start.Unix()] = h
+	}
+	h.Record(int64(res.Duration() / time.Microsecond))
+}
+
+// exclude excludes the requests of the second from the stats.
+func (st *latencyStats) exclude(sec int64, phase string, n int64) {
+	switch phase {
+	case phaseWarmup:
+		st.warmup += n
+	case phaseCooldown:
+		st.cooldown += n
+	}
+	st.phases[sec] = phase
+}
+
+// completed returns the number of requests in the stats,
+// without warm-up and cool-down.
+func (st *latencyStats) completed() int64 {
+	n := st.hist.TotalCount()
+	for _, v := range st.ErrorDist {
+		n += int64(v)
+	}
+	return n
+}
+
+// merge adds the requests of 'other', run after the requests of 'st'
+// (e.g. the next range of client numbers). 'update' must be called after.
+func (st *latencyStats) merge(other latencyStats) {
+	st.Total += other.Total
+	st.warmup += other.warmup
+	st.cooldown += other.cooldown
+	for sec, phase := range other.phases {
+		st.phases[sec] = phase
+	}
+	if !other.start.IsZero() && (st.start.IsZero() || other.start.Before(st.start)) {
+		st.start = other.start
+	}
+	if other.end.After(st.end) {
+		st.end = other.end
+	}
+	if !other.begin.IsZero() && (st.begin.IsZero() || other.begin.Before(st.begin)) {
+		st.begin = other.begin
+	}
+	for k, v := range other.ErrorDist {
+		st.ErrorDist[k] += v
+	}
+	st.errorSamples.merge(other.errorSamples)
+	for sec, n := range other.errorsPerSecond {
+		st.errorsPerSecond[sec] += n
+	}
+	st.hist.Merge(other.hist)
+	for sec, oh := range other.perSecond {
+		h, ok := st.perSecond[sec]
+		if !ok {
+			h = newLatencyHistogram(latencyPerSecondSigFigs)
+			st.perSecond[sec] = h
+		}
+		h.Merge(oh)
+	}
+}
+
+// update computes the stats of 'report.Stats' from the histograms.
+func (st *latencyStats) update() {
+	n := st.hist.TotalCount()
+	st.AvgTotal = st.hist.Sum() / 1e6
+	st.Average = st.hist.Mean() / 1e6
+	st.Stddev = st.hist.StdDev() / 1e6
+	st.Fastest = float64(st.hist.Min()) / 1e6
+	st.Slowest = float64(st.hist.Max()) / 1e6
+	st.RPS = 0
+	if st.Total > 0 {
+		st.RPS = float64(n) / st.Total.Seconds()
+	}
+
+	st.TimeSeries = st.TimeSeries[:0]
+	if len(st.perSecond) == 0 && len(st.errorsPerSecond) == 0 {
+		return
+	}
+	secs := make([]int64, 0, len(st.perSecond)+len(st.errorsPerSecond))
+	for sec := range st.perSecond {
+		secs = append(secs, sec)
+	}
+	for sec := range st.errorsPerSecond {
+		secs = append(secs, sec)
+	}
+	sort.Slice(secs, func(i, j int) bool { return secs[i] < secs[j] })
+	// fill in the seconds without any request
+	for sec := secs[0]; sec <= secs[len(secs)-1]; sec++ {
+		dp := report.DataPoint{Timestamp: sec}
+		if h, ok := st.perSecond[sec]; ok {
+			dp.MinLatency = time.Duration(h.Min()) * time.Microsecond
+			dp.AvgLatency = time.Duration(h.Mean()) * time.Microsecond
+			dp.MaxLatency = time.Duration(h.Max()) * time.Microsecond
+			dp.ThroughPut = h.TotalCount()
+		}
+		st.TimeSeries = append(st.TimeSeries, dp)
+	}
+}
+
+// count returns the number of successful requests.
+func (st *latencyStats) count() int64 {
+	return st.hist.TotalCount()
+}
+
+// succeeded returns the number of successful requests,
+// including warm-up and cool-down.
+func (st *latencyStats) succeeded() int64 {
+	var n int64
+	for _, h := range st.perSecond {
+		n += h.TotalCount()
+	}
+	return n
+}
+
+// percentile returns the latency at the percentile in seconds.
+func (st *latencyStats) percentile(pct float64) float64 {
+	return float64(st.hist.ValueAtPercentile(pct)) / 1e6
+}
+
+// secondErrorRate returns the ratio of failed requests
+// started at the unix second.
+func (st *latencyStats) secondErrorRate(sec int64) float64 {
+	errN := st.errorsPerSecond[sec]
+	if errN == 0 {
+		return 0
+	}
+	var n int64
+	if h, ok := st.perSecond[sec]; ok {
+		n = h.TotalCount()
+	}
+	return float64(errN) / float64(errN+n)
+}
+
+// secondPhase returns the phase of the unix second,
+// "warmup" or "cooldown" with any excluded request.
+func (st *latencyStats) secondPhase(sec int64) string {
+	if phase, ok := st.phases[sec]; ok {
+		return phase
+	}
+	return phaseMeasure
+}
+
+// secondPercentile returns the latency at the percentile
+// of requests started at the unix second.
+func (st *latencyStats) secondPercentile(sec int64, pct float64) time.Duration {
+	h, ok := st.perSecond[sec]
+	if !ok {
+		return 0
+	}
+	return time.Duration(h.ValueAtPercentile(pct)) * time.Microsecond
+}
+
+const (
+	phaseWarmup   = "warmup"
+	phaseMeasure  = "measure"
+	phaseCooldown = "cooldown"
+)
+
+// latencyPhases are the warm-up and cool-down phases,
+// whose requests are excluded from the stats.
+type latencyPhases struct {
+	warmupSeconds    int64
+	warmupRequests   int64
+	cooldownSeconds  int64
+	cooldownRequests int64
+}
+
+func newLatencyPhases(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) latencyPhases {
+	return latencyPhases{
+		warmupSeconds:    opts.WarmupSeconds,
+		warmupRequests:   opts.WarmupRequests,
+		cooldownSeconds:  opts.CooldownSeconds,
+		cooldownRequests: opts.CooldownRequests,
+	}
+}
+
+func (p latencyPhases) enabled() bool {
+	return p != latencyPhases{}
+}
+
+// latencyRecorder is report.Report with latency histograms,
+// which records any number of requests in bounded memory.
+type latencyRecorder struct {
+	results chan report.Result
+	stats   latencyStats
+	phases  latencyPhases
+
+	received int64
+	// lastRequests are the last 'cooldownRequests' requests, and
+	// lastSeconds are the stats of the requests started in the last
+	// 'cooldownSeconds' seconds, not recorded until the later requests
+	// push them out. The stats of each second are bounded by the
+	// histogram, not by the number of requests.
+	lastRequests []report.Result
+	lastSeconds  map[int64]*latencyStats
+	latestSecond int64
+	// flushedSecond is the latest second when 'lastSeconds' was flushed,
+	// so that it is flushed once per second, not on every request.
+	flushedSecond int64
+}
+
+// newLatencyRecorder returns the recorder of the requests sent from
+// 'begin', from which the warm-up seconds are measured.
+func newLatencyRecorder(phases latencyPhases, begin time.Time) *latencyRecorder {
+	r := &latencyRecorder{
+		results:     make(chan report.Result, 16),
+		stats:       newLatencyStats(),
+		phases:      phases,
+		lastSeconds: make(map[int64]*latencyStats),
+	}
+	r.stats.begin = begin
+	return r
+}
+
+func (r *latencyRecorder) Results() chan<- report.Result { return r.results }
+
+// Stats returns the stats once 'Results' is closed.
+func (r *latencyRecorder) Stats() <-chan latencyStats {
+	donec := make(chan latencyStats, 1)
+	go func() {
+		defer close(donec)
+		start := time.Now()
+		for res := range r.results {
+			r.process(res)
+		}
+		r.finish()
+		r.stats.Total = time.Since(start)
+		if r.phases.enabled() {
+			// only the duration of the measured requests
+			r.stats.Total = r.stats.end.Sub(r.stats.start)
+		}
+		r.stats.update()
+		donec <- r.stats
+	}()
+	return donec
+}
+
+func (r *latencyRecorder) process(res report.Result) {
+	r.stats.recordSecond(res)
+	sec := res.Start.Unix()
+	r.received++
+	if sec > r.latestSecond {
+		r.latestSecond = sec
+	}
+
+	if r.received <= r.phases.warmupRequests ||
+		res.Start.Before(r.stats.begin.Add(time.Duration(r.phases.warmupSeconds)*time.Second)) {
+		r.stats.exclude(sec, phaseWarmup, 1)
+		return
+	}
+
+	if r.phases.cooldownRequests > 0 {
+		r.lastRequests = append(r.lastRequests, res)
+		if int64(len(r.lastRequests)) <= r.phases.cooldownRequests {
+			return
+		}
+		res = r.lastRequests[0]
+		r.lastRequests = r.lastRequests[1:]
+	}
+
+	if r.phases.cooldownSeconds > 0 {
+		if r.latestSecond > r.flushedSecond {
+			r.flushSeconds()
+		}
+		sec = res.Start.Unix()
+		if sec > r.latestSecond-r.phases.cooldownSeconds {
+			st, ok := r.lastSeconds[sec]
+			if !ok {
+				ls := newLatencyStats()
+				st = &ls
+				r.lastSeconds[sec] = st
+			}
+			st.record(res)
+			return
+		}
+	}
+
+	r.stats.record(res)
+}
+
+// flushSeconds records the held seconds pushed out by the later requests.
+func (r *latencyRecorder) flushSeconds() {
+	r.flushedSecond = r.latestSecond
+	for sec, st := range r.lastSeconds {
+		if sec <= r.latestSecond-r.phases.cooldownSeconds {
+			r.stats.merge(*st)
+			delete(r.lastSeconds, sec)
+		}
+	}
+}
+
+// finish excludes the requests still held for the cool-down.
+func (r *latencyRecorder) finish() {
+	for _, res := range r.lastRequests {
+		r.stats.exclude(res.Start.Unix(), phaseCooldown, 1)
+	}
+	r.lastRequests = nil
+	r.flushSeconds()
+	for sec, st := range r.lastSeconds {
+		r.stats.exclude(sec, phaseCooldown, st.completed())
+		delete(r.lastSeconds, sec)
+	}
+}
+
+// LatencyHistogramPath returns the path of the serialized latency
+// histogram, next to the latency percentile file.
+func LatencyHistogramPath(percentilePath string) string {
+	ext := filepath.Ext(percentilePath)
+	return strings.TrimSuffix(percentilePath, ext) + "-histogram.json"
+}
@@ -0,0 +1,242 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/etcd/pkg/report"
+)
+
+func Test_latencyRecorder(t *testing.T) {
+	start := time.Unix(1000, 0)
+	rec := func(r *latencyRecorder, sec int64, lats ...time.Duration) {
+		for _, lat := range lats {
+			st := start.Add(time.Duration(sec) * time.Second)
+			r.Results() <- report.Result{Start: st, End: st.Add(lat)}
+		}
+	}
+
+	r1 := newLatencyRecorder(latencyPhases{}, time.Time{})
+	donec1 := r1.Stats()
+	rec(r1, 0, 10*time.Millisecond, 20*time.Millisecond)
+	rec(r1, 2, 30*time.Millisecond)
+	r1.Results() <- report.Result{Err: errors.New("fail"), Start: start, End: start}
+	close(r1.Results())
+	st := <-donec1
+
+	if st.Lats != nil {
+		t.Fatalf("expected no latency kept, got %d", len(st.Lats))
+	}
+	if completedRequests(st) != 4 || st.count() != 3 {
+		t.Fatalf("expected 4 completed and 3 successful requests, got %d, %d", completedRequests(st), st.count())
+	}
+	if st.ErrorDist[ErrorOther] != 1 || st.errorSamples[ErrorOther][0] != "fail" {
+		t.Fatalf("expected 1 %q error with the sample message, got %v, %v", ErrorOther, st.ErrorDist, st.errorSamples)
+	}
+	if r := st.secondErrorRate(1000); r != 1.0/3 {
+		t.Fatalf("expected error rate 1/3, got %f", r)
+	}
+	if st.Fastest != 0.01 || st.Slowest != 0.03 || st.Average != 0.02 {
+		t.Fatalf("unexpected fastest %f, slowest %f, average %f", st.Fastest, st.Slowest, st.Average)
+	}
+	// empty second in the middle is filled in
+	expected := report.TimeSeries{
+		{Timestamp: 1000, MinLatency: 10 * time.Millisecond, AvgLatency: 15 * time.Millisecond, MaxLatency: 20 * time.Millisecond, ThroughPut: 2},
+		{Timestamp: 1001},
+		{Timestamp: 1002, MinLatency: 30 * time.Millisecond, AvgLatency: 30 * time.Millisecond, MaxLatency: 30 * time.Millisecond, ThroughPut: 1},
+	}
+	if len(st.TimeSeries) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, st.TimeSeries)
+	}
+	for i := range expected {
+		if st.TimeSeries[i] != expected[i] {
+			t.Fatalf("#%d: expected %+v, got %+v", i, expected[i], st.TimeSeries[i])
+		}
+	}
+
+	r2 := newLatencyRecorder(latencyPhases{}, time.Time{})
+	donec2 := r2.Stats()
+	rec(r2, 2, 50*time.Millisecond)
+	rec(r2, 3, 100*time.Millisecond)
+	close(r2.Results())
+
+	combined := newLatencyStats()
+	combined.merge(st)
+	combined.merge(<-donec2)
+	combined.update()
+	if completedRequests(combined) != 6 || combined.ErrorDist[ErrorOther] != 1 {
+		t.Fatalf("expected 6 completed requests with 1 error, got %d, %v", completedRequests(combined), combined.ErrorDist)
+	}
+	if len(combined.TimeSeries) != 4 || combined.TimeSeries[2].ThroughPut != 2 {
+		t.Fatalf("expected 4 seconds with the duplicate second merged, got %+v", combined.TimeSeries)
+	}
+	if p := combined.percentile(99.999); p != 0.1 {
+		t.Fatalf("expected p99.999 100ms, got %f", p)
+	}
+	if p := combined.percentile(50); p < 0.0299 || p > 0.0301 {
+		t.Fatalf("expected p50 30ms, got %f", p)
+	}
+}
+
+func Test_latencyHistogram(t *testing.T) {
+	// the precision of each second
+	h := newLatencyHistogram(latencyPerSecondSigFigs)
+	for _, v := range []int64{1001, 1999, 3000} {
+		h.Record(v)
+	}
+	if h.TotalCount() != 3 || h.Min() != 1001 || h.Max() != 3000 || h.Mean() != 2000 {
+		t.Fatalf("expected exact count, min, max and mean, got %d, %d, %d, %f", h.TotalCount(), h.Min(), h.Max(), h.Mean())
+	}
+	if p := h.ValueAtPercentile(100); p != 3000 {
+		t.Fatalf("expected p100 at most the maximum, got %d", p)
+	}
+	if p := h.ValueAtPercentile(50); p < 1800 || p > 2200 {
+		t.Fatalf("expected p50 about 2000, got %d", p)
+	}
+
+	var buf bytes.Buffer
+	if err := h.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeLatencyHistogram(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged := newLatencyHistogram(latencyHistogramSigFigs)
+	merged.Record(int64(2 * latencyHistogramHighest / time.Microsecond))
+	merged.Merge(decoded)
+	if merged.TotalCount() != 4 || merged.Min() != 1001 || merged.Max() != int64(latencyHistogramHighest/time.Microsecond) {
+		t.Fatalf("expected merged count, min and max, got %d, %d, %d", merged.TotalCount(), merged.Min(), merged.Max())
+	}
+	if _, err = decodeLatencyHistogram(bytes.NewBufferString(`{"histogram":"eA=="}`)); err == nil {
+		t.Fatal("expected invalid histogram error")
+	}
+}
+
+func Test_percentileName(t *testing.T) {
+	for pct, expected := range map[float64]string{50: "p50", 99.9: "p99.9", 99.999: "p99.999"} {
+		if name := percentileName(pct); name != expected {
+			t.Fatalf("expected %q, got %q", expected, name)
+		}
+	}
+}
+
+func Test_latencyRecorderPhases(t *testing.T) {
+	start := time.Unix(1000, 0)
+	run := func(phases latencyPhases) latencyStats {
+		r := newLatencyRecorder(phases, start)
+		donec := r.Stats()
+		// 10 requests in each of 5 seconds, slower in the first and last seconds
+		for sec := 0; sec < 5; sec++ {
+			lat := 10 * time.Millisecond
+			if sec == 0 || sec == 4 {
+				lat = time.Second
+			}
+			for i := 0; i < 10; i++ {
+				st := start.Add(time.Duration(sec)*time.Second + time.Duration(i)*time.Millisecond)
+				r.Results() <- report.Result{Start: st, End: st.Add(lat)}
+			}
+		}
+		close(r.Results())
+		return <-donec
+	}
+
+	for i, tt := range []struct {
+		phases   latencyPhases
+		warmup   int64
+		cooldown int64
+	}{
+		{latencyPhases{warmupSeconds: 1, cooldownSeconds: 1}, 10, 10},
+		{latencyPhases{warmupRequests: 10, cooldownRequests: 10}, 10, 10},
+		{latencyPhases{warmupSeconds: 1, cooldownRequests: 10}, 10, 10},
+	} {
+		st := run(tt.phases)
+		if st.warmup != tt.warmup || st.cooldown != tt.cooldown {
+			t.Fatalf("#%d: expected warm-up %d, cool-down %d, got %d, %d", i, tt.warmup, tt.cooldown, st.warmup, st.cooldown)
+		}
+		if st.count() != 30 || completedRequests(st) != 50 {
+			t.Fatalf("#%d: expected 30 measured out of 50 requests, got %d, %d", i, st.count(), completedRequests(st))
+		}
+		if st.Slowest != 0.01 {
+			t.Fatalf("#%d: expected slowest 10ms without warm-up and cool-down, got %f", i, st.Slowest)
+		}
+		// time series still have all requests
+		if len(st.TimeSeries) != 5 || st.succeeded() != 50 {
+			t.Fatalf("#%d: expected 5 seconds of 50 requests, got %d, %d", i, len(st.TimeSeries), st.succeeded())
+		}
+		for sec, expected := range []string{phaseWarmup, phaseMeasure, phaseMeasure, phaseMeasure, phaseCooldown} {
+			if phase := st.secondPhase(1000 + int64(sec)); phase != expected {
+				t.Fatalf("#%d: second %d expected %q, got %q", i, sec, expected, phase)
+			}
+		}
+	}
+}
+
+func Test_latencyRecorderCooldownLate(t *testing.T) {
+	start := time.Unix(1000, 0)
+	r := newLatencyRecorder(latencyPhases{cooldownSeconds: 1}, start)
+	donec := r.Stats()
+	for _, sec := range []int{0, 1, 2, 3, 0} {
+		st := start.Add(time.Duration(sec) * time.Second)
+		r.Results() <- report.Result{Start: st, End: st.Add(10 * time.Millisecond)}
+	}
+	close(r.Results())
+	st := <-donec
+	// the late request of the first second is already out of the cool-down
+	if st.cooldown != 1 || st.count() != 4 {
+		t.Fatalf("expected 1 cool-down out of 5 requests, got %d, %d", st.cooldown, st.count())
+	}
+}
+
+func Test_latencyRecorderWarmupBegin(t *testing.T) {
+	begin := time.Unix(1000, 0)
+	r := newLatencyRecorder(latencyPhases{warmupSeconds: 1}, begin)
+	donec := r.Stats()
+	// the request started later returns first
+	for _, d := range []time.Duration{1500 * time.Millisecond, 200 * time.Millisecond, 2 * time.Second} {
+		st := begin.Add(d)
+		r.Results() <- report.Result{Start: st, End: st.Add(10 * time.Millisecond)}
+	}
+	close(r.Results())
+	st := <-donec
+	// only the request started in the first second from the beginning
+	if st.warmup != 1 || st.count() != 2 {
+		t.Fatalf("expected 1 warm-up out of 3 requests, got %d, %d", st.warmup, st.count())
+	}
+	if !st.begin.Equal(begin) {
+		t.Fatalf("expected begin %v, got %v", begin, st.begin)
+	}
+}
+
+func Test_latencyRecorderCooldownErrors(t *testing.T) {
+	start := time.Unix(1000, 0)
+	r := newLatencyRecorder(latencyPhases{cooldownSeconds: 1}, start)
+	donec := r.Stats()
+	for sec := 0; sec < 3; sec++ {
+		st := start.Add(time.Duration(sec) * time.Second)
+		r.Results() <- report.Result{Start: st, End: st.Add(10 * time.Millisecond)}
+		r.Results() <- report.Result{Err: errRequestTimeout, Start: st, End: st.Add(time.Second)}
+	}
+	close(r.Results())
+	st := <-donec
+	// the failed requests are held with the successful ones
+	if st.cooldown != 2 || st.count() != 2 || st.ErrorDist[ErrorTimeout] != 2 {
+		t.Fatalf("expected 2 cool-down, 2 successful and 2 failed requests, got %d, %d, %d", st.cooldown, st.count(), st.ErrorDist[ErrorTimeout])
+	}
+}
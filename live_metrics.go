@@ -0,0 +1,215 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/etcd-io/etcd/pkg/report"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// latencyBuckets are the upper bounds of the live latency histograms in seconds.
+var latencyBuckets = []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// outcomeSuccess is the outcome of successful requests,
+// while failed ones are of their error categories.
+const outcomeSuccess = "success"
+
+// liveMetrics are the Prometheus metrics of the running benchmark,
+// to watch long runs before the results are saved.
+type liveMetrics struct {
+	registry *prometheus.Registry
+
+	requests   *prometheus.CounterVec
+	latency    *prometheus.HistogramVec
+	clients    prometheus.Gauge
+	targetRate prometheus.Gauge
+	phase      *prometheus.GaugeVec
+
+	// run is the phase tracker of the current benchmark
+	run atomic.Value
+}
+
+func newLiveMetrics() *liveMetrics {
+	m := &liveMetrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "dbtester_requests_total",
+			Help: "Number of completed requests by operation and outcome (success or error category).",
+		}, []string{"op", "outcome"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "dbtester_request_duration_seconds",
+			Help:    "Latency of successful requests by operation.",
+			Buckets: latencyBuckets,
+		}, []string{"op"}),
+		clients: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "dbtester_clients",
+			Help: "Number of clients sending requests.",
+		}),
+		targetRate: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "dbtester_target_requests_per_second",
+			Help: "Target request rate, 0 for no rate limit.",
+		}),
+		phase: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "dbtester_phase",
+			Help: "1 for the current phase of the benchmark.",
+		}, []string{"phase"}),
+	}
+	m.registry.MustRegister(m.requests, m.latency, m.clients, m.targetRate, m.phase)
+	m.setPhase("")
+	return m
+}
+
+// handler serves the metrics in the Prometheus exposition format.
+func (m *liveMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ServeMetrics serves the live Prometheus metrics of the benchmarks
+// at "/metrics" on the address, until the process exits.
+func (cfg *Config) ServeMetrics(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	cfg.liveMetrics = newLiveMetrics()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", cfg.liveMetrics.handler())
+	go func() {
+		if err := http.Serve(ln, mux); err != nil {
+			cfg.lg.Warn("metrics server stopped", zap.Error(err))
+		}
+	}()
+	cfg.lg.Info("serving live metrics", zap.String("address", ln.Addr().String()))
+	return nil
+}
+
+// liveRun tracks the phase of the benchmark as requests complete. It is
+// approximate, while the results exclude the exact warm-up and cool-down.
+type liveRun struct {
+	phases   latencyPhases
+	totalN   int64
+	duration time.Duration
+	start    time.Time
+	// profile is the target rate over time, empty for a constant rate
+	profile loadProfile
+
+	completed int64
+	phase     atomic.Value
+}
+
+// begin resets the metrics of the benchmark to be started.
+// It is a no-op on nil metrics.
+func (m *liveMetrics) begin(b *benchmark, gcfg dbtesterpb.ConfigClientMachineAgentControl) {
+	if m == nil {
+		return
+	}
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	m.clients.Set(float64(opts.ClientNumber))
+	run := &liveRun{
+		phases:   b.phases,
+		totalN:   opts.RequestNumber,
+		duration: time.Duration(opts.DurationSeconds) * time.Second,
+		start:    time.Now(),
+		profile:  loadProfile(opts.LoadProfile),
+	}
+	if len(run.profile) > 0 {
+		m.setTargetRate(run.profile.rate(0))
+	} else {
+		m.setTargetRate(float64(opts.RateLimitRequestsPerSecond))
+	}
+	run.phase.Store("")
+	m.run.Store(run)
+	m.setPhase(run.current(0))
+	b.liveMetrics = m
+}
+
+// observe records the completed request.
+func (m *liveMetrics) observe(op Op, res report.Result) {
+	if m == nil {
+		return
+	}
+	if res.Err != nil {
+		m.requests.WithLabelValues(op.String(), errorCategory(res.Err)).Inc()
+	} else {
+		m.requests.WithLabelValues(op.String(), outcomeSuccess).Inc()
+		m.latency.WithLabelValues(op.String()).Observe(res.Duration().Seconds())
+	}
+
+	run, ok := m.run.Load().(*liveRun)
+	if !ok {
+		return
+	}
+	if len(run.profile) > 0 {
+		m.setTargetRate(run.profile.rate(time.Since(run.start)))
+	}
+	phase := run.current(atomic.AddInt64(&run.completed, 1))
+	if run.phase.Load().(string) != phase {
+		run.phase.Store(phase)
+		m.setPhase(phase)
+	}
+}
+
+// finish marks the end of the benchmark.
+func (m *liveMetrics) finish() {
+	if m == nil {
+		return
+	}
+	m.clients.Set(0)
+	m.setPhase("")
+}
+
+func (m *liveMetrics) setTargetRate(rps float64) {
+	if m == nil {
+		return
+	}
+	m.targetRate.Set(rps)
+}
+
+// setPhase sets 1 to the phase and 0 to the others,
+// or 0 to all on an empty phase between benchmarks.
+func (m *liveMetrics) setPhase(phase string) {
+	for _, p := range []string{phaseWarmup, phaseMeasure, phaseCooldown} {
+		v := 0.0
+		if p == phase {
+			v = 1
+		}
+		m.phase.WithLabelValues(p).Set(v)
+	}
+}
+
+// current returns the phase after 'completed' requests.
+func (r *liveRun) current(completed int64) string {
+	elapsed := time.Since(r.start)
+	p := r.phases
+	switch {
+	case elapsed < time.Duration(p.warmupSeconds)*time.Second || (p.warmupRequests > 0 && completed <= p.warmupRequests):
+		return phaseWarmup
+	case p.cooldownRequests > 0 && r.totalN > 0 && completed > r.totalN-p.cooldownRequests:
+		return phaseCooldown
+	case p.cooldownSeconds > 0 && r.duration > 0 && elapsed >= r.duration-time.Duration(p.cooldownSeconds)*time.Second:
+		return phaseCooldown
+	}
+	return phaseMeasure
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+)
+
+func Test_liveMetrics(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber:              10,
+			ClientNumber:               2,
+			KeySizeBytes:               8,
+			RateLimitRequestsPerSecond: 1000,
+			CooldownRequests:           2,
+		},
+	}
+	// every fifth request fails with no leader
+	var n int64
+	h := []ReqHandler{
+		func(ctx context.Context, req *Request) error {
+			if atomic.AddInt64(&n, 1)%5 == 0 {
+				return &categorizedError{category: ErrorNoLeader, err: errors.New("no leader")}
+			}
+			return nil
+		},
+	}
+	h = append(h, h[0])
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateReads(ctx, gcfg, "foo", nil, inflightReqs)
+	}
+	m := newLiveMetrics()
+	b := newBenchmark(context.Background(), 10, 2, newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions), 0, retryPolicy{}, h, nil, reqGen)
+	m.begin(b, gcfg)
+
+	read := func() string {
+		rec := httptest.NewRecorder()
+		m.handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+		return rec.Body.String()
+	}
+	for _, line := range []string{
+		"dbtester_clients 2\n",
+		"dbtester_target_requests_per_second 1000\n",
+		`dbtester_phase{phase="measure"} 1` + "\n",
+	} {
+		if out := read(); !strings.Contains(out, line) {
+			t.Fatalf("expected %q before requests, got\n%s", line, out)
+		}
+	}
+
+	b.startRequests()
+	b.waitAll()
+	out := read()
+	for _, line := range []string{
+		`dbtester_requests_total{op="GET",outcome="success"} 8` + "\n",
+		`dbtester_requests_total{op="GET",outcome="no-leader"} 2` + "\n",
+		`dbtester_request_duration_seconds_count{op="GET"} 8` + "\n",
+		"dbtester_clients 0\n",
+		`dbtester_phase{phase="cooldown"} 0` + "\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("expected %q after requests, got\n%s", line, out)
+		}
+	}
+}
+
+func Test_liveRunPhase(t *testing.T) {
+	r := &liveRun{
+		phases: latencyPhases{warmupRequests: 2, cooldownRequests: 3},
+		totalN: 10,
+		start:  time.Now(),
+	}
+	for completed, expected := range []string{phaseWarmup, phaseWarmup, phaseWarmup, phaseMeasure, phaseMeasure, phaseMeasure, phaseMeasure, phaseMeasure, phaseCooldown, phaseCooldown, phaseCooldown} {
+		if phase := r.current(int64(completed)); phase != expected {
+			t.Fatalf("#%d: expected %q, got %q", completed, expected, phase)
+		}
+	}
+
+	r = &liveRun{
+		phases:   latencyPhases{warmupSeconds: 60, cooldownSeconds: 60},
+		duration: 10 * time.Minute,
+		start:    time.Now().Add(-5 * time.Minute),
+	}
+	if phase := r.current(100); phase != phaseMeasure {
+		t.Fatalf("expected %q, got %q", phaseMeasure, phase)
+	}
+	r.start = time.Now().Add(-9*time.Minute - 30*time.Second)
+	if phase := r.current(100); phase != phaseCooldown {
+		t.Fatalf("expected %q, got %q", phaseCooldown, phase)
+	}
+}
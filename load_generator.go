@@ -0,0 +1,371 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// loadGeneratorStartTimeout is how long the ready load generator waits
+// for the start, not to wait forever once the control machine is gone.
+// The first load generator preloads the keys before the others are
+// prepared, so the ready ones only wait for the others to connect.
+const loadGeneratorStartTimeout = 10 * time.Minute
+
+// LoadGenerator runs the part of a distributed benchmark on a load
+// generator machine, as requested by the control machine via the agent:
+// "StressPrepare" creates it, "StressStart" starts the requests in lockstep
+// with the other load generators, "StressResult" returns the stats, and
+// "StressCancel" stops the requests, returning the stats so far.
+type LoadGenerator struct {
+	part  *loadPart
+	donec chan struct{}
+	err   error
+}
+
+// NewLoadGenerator starts preparing the part of the benchmark in the request.
+func NewLoadGenerator(lg *zap.Logger, req *dbtesterpb.Request) (*LoadGenerator, error) {
+	if req.ConfigClientMachineAgentControl == nil || req.ConfigClientMachineAgentControl.ConfigClientMachineBenchmarkOptions == nil {
+		return nil, errors.New("no benchmark options to run")
+	}
+	gcfg := *req.ConfigClientMachineAgentControl
+	// not to split the part again, and the control
+	// machine signals the database agents
+	gcfg.LoadGeneratorEndpoints = nil
+	gcfg.AgentEndpoints = nil
+	if gcfg.Flag_Ytsaurus_Cypress != nil {
+		// not sent by the control machine
+		flag := *gcfg.Flag_Ytsaurus_Cypress
+		if err := readYTsaurusToken(&flag); err != nil {
+			return nil, err
+		}
+		gcfg.Flag_Ytsaurus_Cypress = &flag
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	part := &loadPart{
+		index:         req.LoadGeneratorIndex,
+		requestOffset: req.RequestOffset,
+		ctx:           ctx,
+		cancel:        cancel,
+		readyc:        make(chan struct{}),
+		startc:        make(chan struct{}),
+	}
+	cfg := &Config{
+		lg: lg,
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{gcfg.DatabaseID: gcfg},
+		part: part,
+	}
+	g := &LoadGenerator{part: part, donec: make(chan struct{})}
+	go func() {
+		defer close(g.donec)
+		defer cancel()
+		g.err = cfg.Stress(gcfg.DatabaseID)
+	}()
+	return g, nil
+}
+
+// WaitReady returns when the load generator is ready to send requests.
+func (g *LoadGenerator) WaitReady(ctx context.Context) error {
+	select {
+	case <-g.part.readyc:
+		return nil
+	case <-g.donec:
+		if g.err != nil {
+			return g.err
+		}
+		return errors.New("load generator finished before sending requests")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Start starts the requests at the time.
+func (g *LoadGenerator) Start(at time.Time) {
+	g.part.start(at)
+}
+
+// Cancel stops preparing or sending the requests,
+// and Wait returns the stats so far.
+func (g *LoadGenerator) Cancel() {
+	g.part.cancel()
+}
+
+// Done is closed when the load generator is finished.
+func (g *LoadGenerator) Done() <-chan struct{} {
+	return g.donec
+}
+
+// Wait returns the encoded stats after all requests are finished.
+func (g *LoadGenerator) Wait(ctx context.Context) ([]byte, error) {
+	select {
+	case <-g.donec:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if g.err != nil {
+		return nil, g.err
+	}
+	if g.part.statsErr != nil {
+		return nil, g.part.statsErr
+	}
+	if g.part.stats == nil {
+		return nil, errors.New("load generator saved no stats")
+	}
+	return json.Marshal(g.part.stats)
+}
+
+// loadPart is the part of a distributed benchmark run by the load generator.
+type loadPart struct {
+	index uint32
+	// requestOffset is the index of the first sequential key to write.
+	requestOffset int64
+
+	// ctx is canceled by the control machine, or when no start
+	// is received within 'loadGeneratorStartTimeout'.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	readyOnce sync.Once
+	readyc    chan struct{}
+	startOnce sync.Once
+	startc    chan struct{}
+	startAt   time.Time
+
+	// stats are saved by the load generator, instead of the results files.
+	stats    *loadGeneratorStats
+	statsErr error
+}
+
+func (p *loadPart) start(at time.Time) {
+	p.startOnce.Do(func() {
+		p.startAt = at
+		close(p.startc)
+	})
+}
+
+func (p *loadPart) save(stats latencyStats, clientNs []int64, ext extraStats) {
+	p.stats, p.statsErr = newLoadGeneratorStats(stats, clientNs, ext)
+}
+
+// waitStart signals the load generator is ready, and waits for the start
+// time. It returns immediately if not a load generator, or already started.
+// The load generator is canceled if not started within the timeout.
+func (cfg *Config) waitStart(ctx context.Context) {
+	if cfg.part == nil {
+		return
+	}
+	cfg.part.readyOnce.Do(func() { close(cfg.part.readyc) })
+	t := time.NewTimer(loadGeneratorStartTimeout)
+	defer t.Stop()
+	select {
+	case <-cfg.part.startc:
+	case <-t.C:
+		cfg.lg.Warn("load generator is not started, canceling", zap.Duration("timeout", loadGeneratorStartTimeout))
+		cfg.part.cancel()
+		return
+	case <-ctx.Done():
+		return
+	}
+	select {
+	case <-time.After(time.Until(cfg.part.startAt)):
+	case <-ctx.Done():
+	}
+}
+
+// runContext returns the context canceled by the control machine
+// on the load generator, or the background context otherwise.
+func (cfg *Config) runContext() context.Context {
+	if cfg.part == nil {
+		return context.Background()
+	}
+	return cfg.part.ctx
+}
+
+// requestOffset returns the index of the first sequential key to write.
+func (cfg *Config) requestOffset() int64 {
+	if cfg.part == nil {
+		return 0
+	}
+	return cfg.part.requestOffset
+}
+
+// preloadsKeys returns false on the load generators except the first one,
+// which preloads the keys for all.
+func (cfg *Config) preloadsKeys() bool {
+	return cfg.part == nil || cfg.part.index == 0
+}
+
+// loadGeneratorStats are the stats returned by the load generator,
+// to be merged on the control machine.
+type loadGeneratorStats struct {
+	Stats encodedLatencyStats `json:"stats"`
+	// ClientNumbers are the number of clients at each unix second,
+	// only with 'ConnectionClientNumbers'.
+	ClientNumbers map[int64]int64 `json:"client_numbers,omitempty"`
+
+	OpStats           map[Op]encodedLatencyStats `json:"op_stats,omitempty"`
+	RespBytes         int64                      `json:"resp_bytes"`
+	RespBytesRequests int64                      `json:"resp_bytes_requests"`
+	RespBytesBySecond map[int64]int64            `json:"resp_bytes_by_second,omitempty"`
+	Conflicts         int64                      `json:"conflicts"`
+	Uncorrected       *encodedLatencyStats       `json:"uncorrected,omitempty"`
+	RetryRequests     int64                      `json:"retry_requests"`
+	RetryAttempts     int64                      `json:"retry_attempts"`
+	RetryRetried      int64                      `json:"retry_retried"`
+	RetryFailed       int64                      `json:"retry_failed"`
+	RetryLatency      *encodedLatencyStats       `json:"retry_latency,omitempty"`
+	RetryEnabled      bool                       `json:"retry_enabled"`
+	Interrupted       bool                       `json:"interrupted"`
+}
+
+func newLoadGeneratorStats(stats latencyStats, clientNs []int64, ext extraStats) (*loadGeneratorStats, error) {
+	var err error
+	ls := &loadGeneratorStats{
+		RespBytes:         ext.respBytes.total,
+		RespBytesRequests: ext.respBytes.requests,
+		RespBytesBySecond: ext.respBytes.bySecond,
+		Conflicts:         ext.conflicts,
+		Interrupted:       ext.interrupted,
+	}
+	if ls.Stats, err = encodeLatencyStats(stats); err != nil {
+		return nil, err
+	}
+	if len(clientNs) > 0 {
+		ls.ClientNumbers = make(map[int64]int64, len(clientNs))
+		for i, dp := range stats.TimeSeries {
+			ls.ClientNumbers[dp.Timestamp] = clientNs[i]
+		}
+	}
+	if len(ext.opStats) > 0 {
+		ls.OpStats = make(map[Op]encodedLatencyStats, len(ext.opStats))
+		for op, st := range ext.opStats {
+			if ls.OpStats[op], err = encodeLatencyStats(st); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if ext.uncorrected != nil {
+		e, err := encodeLatencyStats(*ext.uncorrected)
+		if err != nil {
+			return nil, err
+		}
+		ls.Uncorrected = &e
+	}
+	if rs := ext.retry; rs != nil {
+		ls.RetryEnabled = true
+		ls.RetryRequests, ls.RetryAttempts, ls.RetryRetried, ls.RetryFailed = rs.requests, rs.attempts, rs.retried, rs.failed
+		if rs.latency != nil {
+			e, err := encodeLatencyStats(*rs.latency)
+			if err != nil {
+				return nil, err
+			}
+			ls.RetryLatency = &e
+		}
+	}
+	return ls, nil
+}
+
+// encodedLatencyStats are the serialized latencyStats,
+// with the histograms encoded by 'latencyHistogram.Encode'.
+type encodedLatencyStats struct {
+	Total           time.Duration             `json:"total"`
+	ErrorDist       map[string]int            `json:"error_dist"`
+	ErrorSamples    map[string][]string       `json:"error_samples"`
+	Histogram       json.RawMessage           `json:"histogram"`
+	PerSecond       map[int64]json.RawMessage `json:"per_second"`
+	ErrorsPerSecond map[int64]int64           `json:"errors_per_second"`
+	Warmup          int64                     `json:"warmup"`
+	Cooldown        int64                     `json:"cooldown"`
+	Phases          map[int64]string          `json:"phases"`
+	Start           time.Time                 `json:"start"`
+	End             time.Time                 `json:"end"`
+	Begin           time.Time                 `json:"begin"`
+}
+
+func encodeHistogram(h *latencyHistogram) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := h.Encode(&buf); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(bytes.TrimSpace(buf.Bytes())), nil
+}
+
+func encodeLatencyStats(st latencyStats) (encodedLatencyStats, error) {
+	e := encodedLatencyStats{
+		Total:           st.Total,
+		ErrorDist:       st.ErrorDist,
+		ErrorSamples:    st.errorSamples,
+		PerSecond:       make(map[int64]json.RawMessage, len(st.perSecond)),
+		ErrorsPerSecond: st.errorsPerSecond,
+		Warmup:          st.warmup,
+		Cooldown:        st.cooldown,
+		Phases:          st.phases,
+		Start:           st.start,
+		End:             st.end,
+		Begin:           st.begin,
+	}
+	var err error
+	if e.Histogram, err = encodeHistogram(st.hist); err != nil {
+		return e, err
+	}
+	for sec, h := range st.perSecond {
+		if e.PerSecond[sec], err = encodeHistogram(h); err != nil {
+			return e, err
+		}
+	}
+	return e, nil
+}
+
+// decode returns the stats, to be merged and updated.
+func (e encodedLatencyStats) decode() (latencyStats, error) {
+	st := newLatencyStats()
+	st.Total = e.Total
+	for k, v := range e.ErrorDist {
+		st.ErrorDist[k] = v
+	}
+	st.errorSamples.merge(e.ErrorSamples)
+	for sec, n := range e.ErrorsPerSecond {
+		st.errorsPerSecond[sec] = n
+	}
+	st.warmup, st.cooldown = e.Warmup, e.Cooldown
+	for sec, phase := range e.Phases {
+		st.phases[sec] = phase
+	}
+	st.start, st.end, st.begin = e.Start, e.End, e.Begin
+
+	h, err := decodeLatencyHistogram(bytes.NewReader(e.Histogram))
+	if err != nil {
+		return st, fmt.Errorf("invalid latency histogram (%v)", err)
+	}
+	st.hist.Merge(h)
+	for sec, raw := range e.PerSecond {
+		if h, err = decodeLatencyHistogram(bytes.NewReader(raw)); err != nil {
+			return st, fmt.Errorf("invalid latency histogram of second %d (%v)", sec, err)
+		}
+		st.perSecond[sec] = h
+	}
+	return st, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// minPacedRate is the lowest rate the requests are paced at,
+// so that a profile rate near 0 slows the requests down
+// instead of stopping them.
+const minPacedRate = 1
+
+// loadProfile is the target request rate over time of 'LoadProfile'.
+type loadProfile []*dbtesterpb.ConfigClientMachineLoadProfileStage
+
+// validateLoadProfile returns an error on invalid stages.
+func validateLoadProfile(stages []*dbtesterpb.ConfigClientMachineLoadProfileStage) error {
+	for i, st := range stages {
+		if st.DurationSeconds <= 0 {
+			return fmt.Errorf("stage %d got invalid duration %d", i, st.DurationSeconds)
+		}
+		if st.RequestsPerSecond < 0 {
+			return fmt.Errorf("stage %d got invalid request rate %d", i, st.RequestsPerSecond)
+		}
+		switch st.Type {
+		case "step":
+		case "ramp":
+			if st.StartRequestsPerSecond < 0 {
+				return fmt.Errorf("stage %d got invalid start request rate %d", i, st.StartRequestsPerSecond)
+			}
+		case "sine":
+			if st.PeriodSeconds <= 0 {
+				return fmt.Errorf("stage %d got invalid period %d", i, st.PeriodSeconds)
+			}
+			if st.AmplitudeRequestsPerSecond < 0 || st.AmplitudeRequestsPerSecond > st.RequestsPerSecond {
+				return fmt.Errorf("stage %d got amplitude %d outside 0 and request rate %d", i, st.AmplitudeRequestsPerSecond, st.RequestsPerSecond)
+			}
+		case "spike":
+			if st.PeakRequestsPerSecond < 0 {
+				return fmt.Errorf("stage %d got invalid peak request rate %d", i, st.PeakRequestsPerSecond)
+			}
+			if st.SpikeSeconds <= 0 || st.SpikeSeconds > st.DurationSeconds {
+				return fmt.Errorf("stage %d got spike %d seconds outside 0 and duration %d", i, st.SpikeSeconds, st.DurationSeconds)
+			}
+		default:
+			return fmt.Errorf("stage %d got unknown type %q (expected one of step, ramp, sine, spike)", i, st.Type)
+		}
+	}
+	return nil
+}
+
+// rate returns the target requests per second at 'elapsed' since
+// the start. After the last stage, it stays at the end of the last stage.
+func (p loadProfile) rate(elapsed time.Duration) float64 {
+	if len(p) == 0 {
+		return 0
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	for _, st := range p {
+		d := time.Duration(st.DurationSeconds) * time.Second
+		if elapsed < d {
+			return stageRate(st, elapsed)
+		}
+		elapsed -= d
+	}
+	last := p[len(p)-1]
+	return stageRate(last, time.Duration(last.DurationSeconds)*time.Second)
+}
+
+// pacedRate returns the rate to pace the requests at 'elapsed'.
+func (p loadProfile) pacedRate(elapsed time.Duration) float64 {
+	return math.Max(p.rate(elapsed), minPacedRate)
+}
+
+// stageRate returns the rate at 'elapsed' since the start of the stage.
+func stageRate(st *dbtesterpb.ConfigClientMachineLoadProfileStage, elapsed time.Duration) float64 {
+	d := time.Duration(st.DurationSeconds) * time.Second
+	rps := float64(st.RequestsPerSecond)
+	switch st.Type {
+	case "ramp":
+		start := float64(st.StartRequestsPerSecond)
+		return start + (rps-start)*elapsed.Seconds()/d.Seconds()
+	case "sine":
+		return rps + float64(st.AmplitudeRequestsPerSecond)*math.Sin(2*math.Pi*elapsed.Seconds()/float64(st.PeriodSeconds))
+	case "spike":
+		spike := time.Duration(st.SpikeSeconds) * time.Second
+		if from := (d - spike) / 2; elapsed >= from && elapsed < from+spike {
+			return float64(st.PeakRequestsPerSecond)
+		}
+	}
+	return rps
+}
+
+// String describes the stages, to be recorded with the results.
+func (p loadProfile) String() string {
+	ss := make([]string, len(p))
+	for i, st := range p {
+		switch st.Type {
+		case "ramp":
+			ss[i] = fmt.Sprintf("ramp(%ds, %d-%d)", st.DurationSeconds, st.StartRequestsPerSecond, st.RequestsPerSecond)
+		case "sine":
+			ss[i] = fmt.Sprintf("sine(%ds, %d, amplitude %d, period %ds)", st.DurationSeconds, st.RequestsPerSecond, st.AmplitudeRequestsPerSecond, st.PeriodSeconds)
+		case "spike":
+			ss[i] = fmt.Sprintf("spike(%ds, %d, peak %d for %ds)", st.DurationSeconds, st.RequestsPerSecond, st.PeakRequestsPerSecond, st.SpikeSeconds)
+		default:
+			ss[i] = fmt.Sprintf("%s(%ds, %d)", st.Type, st.DurationSeconds, st.RequestsPerSecond)
+		}
+	}
+	return strings.Join(ss, " ")
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+)
+
+func Test_loadProfileRate(t *testing.T) {
+	p := loadProfile{
+		{Type: "step", DurationSeconds: 10, RequestsPerSecond: 100},
+		{Type: "ramp", DurationSeconds: 10, StartRequestsPerSecond: 100, RequestsPerSecond: 200},
+		{Type: "sine", DurationSeconds: 20, RequestsPerSecond: 200, AmplitudeRequestsPerSecond: 100, PeriodSeconds: 8},
+		{Type: "spike", DurationSeconds: 10, RequestsPerSecond: 50, PeakRequestsPerSecond: 1000, SpikeSeconds: 2},
+	}
+	if err := validateLoadProfile(p); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		elapsed time.Duration
+		rate    float64
+	}{
+		{-time.Second, 100},
+		{0, 100},
+		{9 * time.Second, 100},
+		{10 * time.Second, 100},
+		{15 * time.Second, 150},
+		{20 * time.Second, 200},
+		{22 * time.Second, 300},
+		{26 * time.Second, 100},
+		{28 * time.Second, 200},
+		{40 * time.Second, 50},
+		{43*time.Second + 999*time.Millisecond, 50},
+		{44 * time.Second, 1000},
+		{45 * time.Second, 1000},
+		{46 * time.Second, 50},
+		// holds at the end of the last stage
+		{time.Hour, 50},
+	}
+	for i, tt := range tests {
+		if r := p.rate(tt.elapsed); math.Abs(r-tt.rate) > 1e-9 {
+			t.Fatalf("#%d: expected %f at %v, got %f", i, tt.rate, tt.elapsed, r)
+		}
+	}
+
+	if r := (loadProfile{{Type: "ramp", DurationSeconds: 10, RequestsPerSecond: 100}}).pacedRate(0); r != minPacedRate {
+		t.Fatalf("expected paced rate %d, got %f", minPacedRate, r)
+	}
+}
+
+func Test_validateLoadProfile(t *testing.T) {
+	tests := []*dbtesterpb.ConfigClientMachineLoadProfileStage{
+		{Type: "step", RequestsPerSecond: 100},
+		{Type: "step", DurationSeconds: 10, RequestsPerSecond: -1},
+		{Type: "sine", DurationSeconds: 10, RequestsPerSecond: 100, AmplitudeRequestsPerSecond: 50},
+		{Type: "sine", DurationSeconds: 10, RequestsPerSecond: 100, AmplitudeRequestsPerSecond: 200, PeriodSeconds: 5},
+		{Type: "spike", DurationSeconds: 10, RequestsPerSecond: 100, PeakRequestsPerSecond: 1000, SpikeSeconds: 20},
+		{Type: "square", DurationSeconds: 10, RequestsPerSecond: 100},
+	}
+	for i, st := range tests {
+		if err := validateLoadProfile([]*dbtesterpb.ConfigClientMachineLoadProfileStage{st}); err == nil {
+			t.Fatalf("#%d: expected error on %+v", i, *st)
+		}
+	}
+}
+
+func Test_requestPacerLoadProfile(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			OpenLoop: true,
+			LoadProfile: []*dbtesterpb.ConfigClientMachineLoadProfileStage{
+				{Type: "step", DurationSeconds: 1, RequestsPerSecond: 100},
+				{Type: "step", DurationSeconds: 1, RequestsPerSecond: 1000},
+			},
+		},
+	}
+	p := newRequestPacer(context.Background(), gcfg)
+	// skip the wait for the schedule
+	p.start = p.start.Add(-time.Hour)
+	p.next = p.start
+
+	// 100 requests in the first second at 10 ms intervals,
+	// then at 1 ms intervals
+	var intended time.Time
+	for i := int64(0); i <= 110; i++ {
+		intended = p.wait(i)
+	}
+	if d := intended.Sub(p.start); d != time.Second+10*time.Millisecond {
+		t.Fatalf("expected the 111th request scheduled at %v, got %v", time.Second+10*time.Millisecond, d)
+	}
+
+	gcfg.ConfigClientMachineBenchmarkOptions.OpenLoop = false
+	p = newRequestPacer(context.Background(), gcfg)
+	if p.limiter.Limit() != 100 || p.limiter.Burst() != 100 {
+		t.Fatalf("expected limit 100 with burst 100, got %v with burst %d", p.limiter.Limit(), p.limiter.Burst())
+	}
+	p.retune(1500 * time.Millisecond)
+	if p.limiter.Limit() != 1000 || p.limiter.Burst() != 1000 {
+		t.Fatalf("expected limit 1000 with burst 1000, got %v with burst %d", p.limiter.Limit(), p.limiter.Burst())
+	}
+}
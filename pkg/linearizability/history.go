@@ -0,0 +1,104 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearizability
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Kind is the kind of register operation.
+type Kind string
+
+const (
+	// Put writes the value to the register.
+	Put Kind = "put"
+	// Get reads the value of the register.
+	Get Kind = "get"
+)
+
+// Operation is a register operation in the history,
+// as invoked and returned by a client.
+type Operation struct {
+	ClientID int64  `json:"client_id"`
+	Kind     Kind   `json:"kind"`
+	Key      string `json:"key"`
+	// Value is the value written by Put, or returned by Get.
+	// The register is empty before the first Put.
+	Value string `json:"value"`
+	// Call and Return are the invocation and response times
+	// in unix nanoseconds.
+	Call   int64 `json:"call"`
+	Return int64 `json:"return"`
+	// Unknown is true for the failed Put, which may or may not take
+	// effect at any time after the invocation (e.g. timed out).
+	Unknown bool `json:"unknown,omitempty"`
+}
+
+func (op Operation) String() string {
+	ret := fmt.Sprintf("%d", op.Return)
+	if op.Unknown {
+		ret = "?"
+	}
+	return fmt.Sprintf("client %d %s(%q)=%q [%d, %s]", op.ClientID, op.Kind, op.Key, op.Value, op.Call, ret)
+}
+
+// WriteHistory writes the operations as JSON lines.
+func WriteHistory(w io.Writer, ops []Operation) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, op := range ops {
+		if err := enc.Encode(op); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadHistory reads the operations written by WriteHistory.
+func ReadHistory(r io.Reader) ([]Operation, error) {
+	var ops []Operation
+	dec := json.NewDecoder(r)
+	for {
+		var op Operation
+		err := dec.Decode(&op)
+		if err == io.EOF {
+			return ops, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if op.Kind != Put && op.Kind != Get {
+			return nil, fmt.Errorf("operation %d got unknown kind %q", len(ops), op.Kind)
+		}
+		if !op.Unknown && op.Return < op.Call {
+			return nil, fmt.Errorf("operation %d returned %d before the call %d", len(ops), op.Return, op.Call)
+		}
+		ops = append(ops, op)
+	}
+}
+
+// ReadHistoryFile reads the history at the path.
+func ReadHistoryFile(fpath string) ([]Operation, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadHistory(f)
+}
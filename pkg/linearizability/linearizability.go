@@ -0,0 +1,309 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package linearizability checks histories of register operations for
+// linearizability, with the algorithm of Wing and Gong as improved by
+// Lowe, the same as Porcupine (https://github.com/anishathalye/porcupine).
+// Each key is an independent register, checked separately.
+package linearizability
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// errTimeout is returned when the search does not finish before the deadline.
+var errTimeout = errors.New("timed out")
+
+// Result is the result of checking the operations on a key.
+type Result struct {
+	Key        string
+	Operations int
+	// Linearizable is false on a violation. It is also false when
+	// the check timed out, with 'TimedOut' set.
+	Linearizable bool
+	TimedOut     bool
+	// Counterexample is a minimal subset of the operations that is not
+	// linearizable by itself, in the order of the calls. It only removes
+	// operations that cannot make a linearizable history non-linearizable,
+	// so it proves the violation of the whole history.
+	Counterexample []Operation
+}
+
+// Check checks the history of each key, within the timeout for each key
+// (0 for no timeout). The results are sorted by key.
+func Check(ops []Operation, timeout time.Duration) []Result {
+	byKey := make(map[string][]Operation)
+	for _, op := range ops {
+		byKey[op.Key] = append(byKey[op.Key], op)
+	}
+	keys := make([]string, 0, len(byKey))
+	for k := range byKey {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rs := make([]Result, 0, len(keys))
+	for _, k := range keys {
+		var deadline time.Time
+		if timeout > 0 {
+			deadline = time.Now().Add(timeout)
+		}
+		r := Result{Key: k, Operations: len(byKey[k])}
+		ok, err := check(byKey[k], deadline)
+		switch {
+		case err != nil:
+			r.TimedOut = true
+		case ok:
+			r.Linearizable = true
+		default:
+			r.Counterexample = minimize(byKey[k], deadline)
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// entry is the call or the return of an operation,
+// in the doubly linked list ordered by time.
+type entry struct {
+	id     int
+	isCall bool
+	time   int64
+	// match is the return entry of the call
+	match      *entry
+	prev, next *entry
+}
+
+// newEntries returns the head of the list of the calls and returns.
+// The unknown operations return after all others.
+func newEntries(ops []Operation) *entry {
+	type event struct {
+		id     int
+		isCall bool
+		time   int64
+	}
+	events := make([]event, 0, 2*len(ops))
+	for i, op := range ops {
+		ret := op.Return
+		if op.Unknown {
+			ret = math.MaxInt64
+		}
+		events = append(events, event{id: i, isCall: true, time: op.Call}, event{id: i, time: ret})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].time != events[j].time {
+			return events[i].time < events[j].time
+		}
+		// calls first, so that the operations at the same time
+		// are concurrent
+		return events[i].isCall && !events[j].isCall
+	})
+
+	head := &entry{id: -1}
+	last := head
+	calls := make(map[int]*entry, len(ops))
+	for _, ev := range events {
+		e := &entry{id: ev.id, isCall: ev.isCall, time: ev.time, prev: last}
+		if ev.isCall {
+			calls[ev.id] = e
+		} else {
+			calls[ev.id].match = e
+		}
+		last.next = e
+		last = e
+	}
+	return head
+}
+
+// lift removes the call and its return from the list.
+func lift(e *entry) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	m := e.match
+	m.prev.next = m.next
+	if m.next != nil {
+		m.next.prev = m.prev
+	}
+}
+
+// unlift puts back the call and its return removed by 'lift'.
+func unlift(e *entry) {
+	m := e.match
+	m.prev.next = m
+	if m.next != nil {
+		m.next.prev = m
+	}
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// step applies the operation to the register state.
+// It returns false if the operation is not valid in the state.
+func step(state string, op Operation) (bool, string) {
+	if op.Kind == Put {
+		return true, op.Value
+	}
+	return op.Value == state, state
+}
+
+// bitset is the set of linearized operations.
+type bitset []uint64
+
+func newBitset(n int) bitset { return make(bitset, (n+63)/64) }
+
+func (b bitset) set(i int)   { b[i/64] |= 1 << uint(i%64) }
+func (b bitset) clear(i int) { b[i/64] &^= 1 << uint(i%64) }
+
+func (b bitset) clone() bitset { return append(bitset(nil), b...) }
+
+func (b bitset) equals(other bitset) bool {
+	for i := range b {
+		if b[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (b bitset) hash() uint64 {
+	// FNV-1a of the words
+	h := uint64(14695981039346656037)
+	for _, w := range b {
+		h ^= w
+		h *= 1099511628211
+	}
+	return h
+}
+
+type cacheEntry struct {
+	linearized bitset
+	state      string
+}
+
+// check returns true if the operations are linearizable, searching
+// for the order of the operations that is valid for the register, and
+// respects the real-time order (an operation returned before the call
+// of another is ordered before it). The states already reached with
+// the same set of linearized operations are not searched again.
+func check(ops []Operation, deadline time.Time) (bool, error) {
+	head := newEntries(ops)
+	linearized := newBitset(len(ops))
+	cache := make(map[uint64][]cacheEntry)
+	type frame struct {
+		e     *entry
+		state string
+	}
+	var stack []frame
+
+	state := ""
+	e := head.next
+	for n := 0; head.next != nil; n++ {
+		if n%1024 == 0 && !deadline.IsZero() && time.Now().After(deadline) {
+			return false, errTimeout
+		}
+		if e.isCall {
+			ok, next := step(state, ops[e.id])
+			if ok {
+				nl := linearized.clone()
+				nl.set(e.id)
+				h := nl.hash()
+				seen := false
+				for _, c := range cache[h] {
+					if c.state == next && c.linearized.equals(nl) {
+						seen = true
+						break
+					}
+				}
+				if !seen {
+					cache[h] = append(cache[h], cacheEntry{linearized: nl, state: next})
+					stack = append(stack, frame{e: e, state: state})
+					state = next
+					linearized.set(e.id)
+					lift(e)
+					e = head.next
+					continue
+				}
+			}
+			e = e.next
+			continue
+		}
+
+		// the operation returned without being linearized,
+		// so undo the last linearized one and try the next
+		if len(stack) == 0 {
+			return false, nil
+		}
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		state = top.state
+		linearized.clear(top.e.id)
+		unlift(top.e)
+		e = top.e.next
+	}
+	return true, nil
+}
+
+// minimize removes the operations from the non-linearizable history,
+// while it stays non-linearizable, in chunks and then one at a time.
+// It only removes Get, and Put of the value that no remaining Get
+// returns, since removing them from a linearizable history keeps it
+// linearizable. It returns the history so far on the deadline.
+func minimize(ops []Operation, deadline time.Time) []Operation {
+	cur := append([]Operation(nil), ops...)
+	for chunk := len(cur) / 2; chunk >= 1; chunk /= 2 {
+		for i := 0; i < len(cur); {
+			if !deadline.IsZero() && time.Now().After(deadline) {
+				return sortByCall(cur)
+			}
+			end := i + chunk
+			if end > len(cur) {
+				end = len(cur)
+			}
+			candidate := append(append([]Operation(nil), cur[:i]...), cur[end:]...)
+			if removable(cur[i:end], candidate) {
+				if ok, err := check(candidate, deadline); err == nil && !ok {
+					cur = candidate
+					continue
+				}
+			}
+			i = end
+		}
+	}
+	return sortByCall(cur)
+}
+
+// removable returns true if removing the operations from
+// a linearizable history keeps the remaining one linearizable.
+func removable(removed, remaining []Operation) bool {
+	read := make(map[string]bool)
+	for _, op := range remaining {
+		if op.Kind == Get {
+			read[op.Value] = true
+		}
+	}
+	for _, op := range removed {
+		if op.Kind == Put && read[op.Value] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortByCall(ops []Operation) []Operation {
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].Call < ops[j].Call })
+	return ops
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearizability
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func put(client int64, key, value string, call, ret int64) Operation {
+	return Operation{ClientID: client, Kind: Put, Key: key, Value: value, Call: call, Return: ret}
+}
+
+func get(client int64, key, value string, call, ret int64) Operation {
+	return Operation{ClientID: client, Kind: Get, Key: key, Value: value, Call: call, Return: ret}
+}
+
+func TestCheck(t *testing.T) {
+	unknown := put(0, "a", "1", 0, 0)
+	unknown.Unknown = true
+	tests := []struct {
+		ops          []Operation
+		linearizable bool
+	}{
+		{[]Operation{get(0, "a", "", 0, 10), put(0, "a", "1", 20, 30), get(1, "a", "1", 40, 50)}, true},
+		// the concurrent get may see either value
+		{[]Operation{put(0, "a", "1", 0, 10), put(0, "a", "2", 20, 30), get(1, "a", "1", 5, 25)}, true},
+		{[]Operation{put(0, "a", "1", 0, 10), put(0, "a", "2", 20, 30), get(1, "a", "2", 5, 25)}, true},
+		{[]Operation{put(0, "a", "1", 0, 10), put(0, "a", "2", 20, 30), get(1, "a", "1", 40, 50)}, false},
+		// reads go back in time
+		{[]Operation{put(0, "a", "1", 0, 100), get(1, "a", "1", 10, 20), get(2, "a", "", 30, 40)}, false},
+		{[]Operation{get(0, "a", "3", 0, 10)}, false},
+		// the failed put may take effect any time after the call
+		{[]Operation{unknown, get(1, "a", "", 50, 60), get(1, "a", "1", 100, 110)}, true},
+		{[]Operation{unknown, get(1, "a", "1", 50, 60), get(1, "a", "", 100, 110)}, false},
+		// independent keys
+		{[]Operation{put(0, "a", "1", 0, 10), put(0, "b", "2", 20, 30), get(1, "b", "2", 40, 50), get(1, "a", "1", 40, 50)}, true},
+	}
+	for i, tt := range tests {
+		rs := Check(tt.ops, 0)
+		linearizable := true
+		for _, r := range rs {
+			if r.TimedOut {
+				t.Fatalf("#%d: unexpected timeout", i)
+			}
+			linearizable = linearizable && r.Linearizable
+		}
+		if linearizable != tt.linearizable {
+			t.Fatalf("#%d: expected linearizable %v, got %+v", i, tt.linearizable, rs)
+		}
+	}
+}
+
+func TestCheckCounterexample(t *testing.T) {
+	violation := []Operation{put(0, "a", "1", 0, 10), put(0, "a", "2", 20, 30), get(1, "a", "1", 1040, 1050)}
+	ops := append([]Operation(nil), violation...)
+	// linearizable operations around the stale read
+	for i := int64(0); i < 100; i++ {
+		ops = append(ops,
+			get(2, "a", "2", 40+10*i, 45+10*i),
+			put(3, "b", fmt.Sprintf("%d", i), 10*i, 10*i+5),
+			get(4, "b", fmt.Sprintf("%d", i), 10*i+6, 10*i+8),
+		)
+	}
+	rs := Check(ops, time.Minute)
+	if len(rs) != 2 {
+		t.Fatalf("expected results of 2 keys, got %+v", rs)
+	}
+	if rs[0].Key != "a" || rs[0].Linearizable || rs[0].Operations != 103 {
+		t.Fatalf("expected violation in 103 operations of %q, got %+v", "a", rs[0])
+	}
+	if !reflect.DeepEqual(rs[0].Counterexample, violation) {
+		t.Fatalf("expected counterexample %v, got %v", violation, rs[0].Counterexample)
+	}
+	if rs[1].Key != "b" || !rs[1].Linearizable || len(rs[1].Counterexample) != 0 {
+		t.Fatalf("expected %q linearizable, got %+v", "b", rs[1])
+	}
+
+	if rs = Check(ops, time.Nanosecond); !rs[0].TimedOut || rs[0].Linearizable {
+		t.Fatalf("expected timeout, got %+v", rs[0])
+	}
+}
+
+func TestHistory(t *testing.T) {
+	unknown := put(0, "a", "2", 20, 0)
+	unknown.Unknown = true
+	ops := []Operation{put(0, "a", "1", 0, 10), unknown, get(1, "a", "1", 5, 25)}
+
+	var buf bytes.Buffer
+	if err := WriteHistory(&buf, ops); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadHistory(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read, ops) {
+		t.Fatalf("expected %+v, got %+v", ops, read)
+	}
+
+	if _, err = ReadHistory(bytes.NewBufferString(`{"kind":"get","call":10,"return":5}`)); err == nil {
+		t.Fatal("expected error on return before call")
+	}
+}
@@ -15,8 +15,11 @@
 package dbtester
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cheggaaa/pb"
@@ -27,38 +30,133 @@ import (
 
 type benchmark struct {
 	bar        *pb.ProgressBar
-	report     report.Report
-	reportDone <-chan report.Stats
-	stats      report.Stats
+	report     *latencyRecorder
+	reportDone <-chan latencyStats
+	stats      latencyStats
+	// phases exclude the warm-up and cool-down requests from all reports
+	phases latencyPhases
+	// begin is when the request generator starts,
+	// set by startRequests before creating the reports
+	begin time.Time
+
+	// per operation kind reports, created on the first request of the kind
+	opMu      sync.Mutex
+	opReports map[Op]*opReport
+	opStats   map[Op]latencyStats
+
+	// uncorrected reports the latency from the actual send time,
+	// created on the first open-loop request
+	uncorrected      *opReport
+	uncorrectedStats *latencyStats
+
+	respMu    sync.Mutex
+	respBytes respBytesStats
+
+	// conflicts is the number of OpTxn requests that did not update the key
+	conflicts int64
+
+	// ctx cancels the run, stopping the request generator and
+	// dropping the requests not started yet
+	ctx context.Context
+	// timeout is the timeout of each request, 0 for no timeout
+	timeout time.Duration
+
+	// retry retries the failed requests, each attempt with the timeout
+	retry retryPolicy
+	// retried reports the latency of the requests with retries,
+	// created on the first retried request
+	retried *opReport
+	// requests, attempts, retriedN and failedN are counted
+	// with the retry policy (see retryStats)
+	requests   int64
+	attempts   int64
+	retriedN   int64
+	failedN    int64
+	retryStats *retryStats
+
+	// liveMetrics are updated on each request, nil if not enabled
+	liveMetrics *liveMetrics
+	// history records the operations of "register" workload,
+	// nil for the other workloads
+	history *historyRecorder
+	// written records the puts to verify, nil if not enabled
+	written *writeRecorder
+	// trace records the requests sent, nil if not enabled
+	trace *traceRecorder
 
 	reqHandlers []ReqHandler
-	reqGen      func(chan<- request)
+	reqGen      func(context.Context, chan<- Request)
 	reqDone     func()
 	wg          sync.WaitGroup
 
 	mu           sync.RWMutex
-	inflightReqs chan request
+	inflightReqs chan Request
 }
 
-// pass totalN in case that 'cfg' is manipulated
-func newBenchmark(totalN int64, clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- request)) (b *benchmark) {
+// respBytesStats is the number of bytes returned by successful requests.
+type respBytesStats struct {
+	total int64
+	// requests is the number of successful requests.
+	requests int64
+	// bySecond is the total bytes returned by requests
+	// started at each unix second.
+	bySecond map[int64]int64
+}
+
+func (rs *respBytesStats) add(start time.Time, n int64) {
+	if rs.bySecond == nil {
+		rs.bySecond = make(map[int64]int64)
+	}
+	rs.total += n
+	rs.requests++
+	rs.bySecond[start.Unix()] += n
+}
+
+func (rs *respBytesStats) merge(other respBytesStats) {
+	if rs.bySecond == nil {
+		rs.bySecond = make(map[int64]int64)
+	}
+	rs.total += other.total
+	rs.requests += other.requests
+	for k, v := range other.bySecond {
+		rs.bySecond[k] += v
+	}
+}
+
+type opReport struct {
+	report     *latencyRecorder
+	reportDone <-chan latencyStats
+}
+
+// pass totalN in case that 'cfg' is manipulated,
+// 0 for the runs only bounded by the duration
+func newBenchmark(ctx context.Context, totalN int64, clientsN int64, phases latencyPhases, timeout time.Duration, retry retryPolicy, reqHandlers []ReqHandler, reqDone func(), reqGen func(context.Context, chan<- Request)) (b *benchmark) {
 	b = &benchmark{
 		bar:         pb.New(int(totalN)),
+		ctx:         ctx,
+		timeout:     timeout,
+		retry:       retry,
 		reqHandlers: reqHandlers,
 		reqGen:      reqGen,
 		reqDone:     reqDone,
 		wg:          sync.WaitGroup{},
+		opReports:   make(map[Op]*opReport),
+		phases:      phases,
 	}
-	b.inflightReqs = make(chan request, clientsN)
+	b.inflightReqs = make(chan Request, clientsN)
 
 	b.bar.Format("Bom !")
+	if totalN <= 0 {
+		// only show the number of completed requests
+		b.bar.ShowPercent = false
+		b.bar.ShowBar = false
+	}
 	b.bar.Start()
-	b.report = report.NewReportSample("%4.4f")
 	return
 }
 
 // only useful when multiple ranges of requests are run with one report
-func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
+func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(context.Context, chan<- Request)) {
 	if len(reqHandlers) == 0 {
 		panic(fmt.Errorf("got 0 reqHandlers"))
 	}
@@ -68,11 +166,11 @@ func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func
 
 	// inflight requests will be dropped!
 	b.mu.Lock()
-	b.inflightReqs = make(chan request, clientsN)
+	b.inflightReqs = make(chan Request, clientsN)
 	b.mu.Unlock()
 }
 
-func (b *benchmark) getInflightsReqs() (ch chan request) {
+func (b *benchmark) getInflightsReqs() (ch chan Request) {
 	b.mu.RLock()
 	ch = b.inflightReqs
 	b.mu.RUnlock()
@@ -80,27 +178,180 @@ func (b *benchmark) getInflightsReqs() (ch chan request) {
 }
 
 func (b *benchmark) startRequests() {
+	b.begin = time.Now()
+	b.report = newLatencyRecorder(b.phases, b.begin)
 	for i := range b.reqHandlers {
 		b.wg.Add(1)
-		go func(rh ReqHandler) {
+		go func(clientID int, rh ReqHandler) {
 			defer b.wg.Done()
+
+			// merge once at the end, not to lock on every request
+			var rs respBytesStats
+			// the call of this client left running on the timeout
+			var abandoned chan error
+			defer func() {
+				b.respMu.Lock()
+				b.respBytes.merge(rs)
+				b.respMu.Unlock()
+			}()
+
 			for req := range b.getInflightsReqs() {
 				if rh == nil {
 					panic(fmt.Errorf("got nil rh"))
 				}
+				if b.ctx.Err() != nil {
+					// drain the requests generated before the cancel
+					continue
+				}
 				st := time.Now()
-				err := rh(context.Background(), &req)
-				b.report.Results() <- report.Result{Err: err, Start: st, End: time.Now()}
+				b.trace.record(req, st)
+				attempts, err := b.doRetry(rh, &req, &abandoned)
+				end := time.Now()
+				// the canceled put may still take effect
+				b.history.record(clientID, req, st, end, err)
+				b.written.record(req, st, end, err)
+				if err != nil && b.ctx.Err() != nil {
+					// not the database failure, but stopped by the cancel
+					continue
+				}
+				res := report.Result{Err: err, Start: st, End: end}
+				if !req.Intended.IsZero() {
+					b.getUncorrectedReport().Results() <- res
+					// include the time queued behind slower requests
+					res.Start = req.Intended
+				}
+				b.report.Results() <- res
+				b.getOpReport(req.Op).Results() <- res
+				b.liveMetrics.observe(req.Op, res)
+				if b.retry.enabled() {
+					atomic.AddInt64(&b.requests, 1)
+					atomic.AddInt64(&b.attempts, attempts)
+					if attempts > 1 {
+						atomic.AddInt64(&b.retriedN, 1)
+						if err != nil {
+							atomic.AddInt64(&b.failedN, 1)
+						}
+						b.getRetriedReport().Results() <- res
+					}
+				}
+				if err == nil {
+					rs.add(st, req.RespBytes)
+				}
+				if req.Conflict {
+					atomic.AddInt64(&b.conflicts, 1)
+				}
 				b.bar.Increment()
 			}
-		}(b.reqHandlers[i])
+		}(i, b.reqHandlers[i])
 	}
-	go b.reqGen(b.getInflightsReqs())
+	go b.reqGen(b.ctx, b.getInflightsReqs())
 	b.reportDone = b.report.Stats()
 }
 
+// errRequestTimeout is the error of the requests
+// not finished within the timeout.
+var errRequestTimeout = errors.New("request timeout")
+
+// do runs the request with the timeout. It returns on the timeout
+// or the cancel, even if the client ignores the context (e.g. ZooKeeper),
+// leaving the request running in background. At most one such call is
+// left per client in 'abandoned', and the next request waits for it
+// within its own timeout, not to pile up the calls on a stuck client.
+func (b *benchmark) do(rh ReqHandler, req *Request, abandoned *chan error) error {
+	if b.timeout <= 0 {
+		return rh(b.ctx, req)
+	}
+	ctx, cancel := context.WithTimeout(b.ctx, b.timeout)
+	defer cancel()
+
+	if *abandoned != nil {
+		select {
+		case <-*abandoned:
+			*abandoned = nil
+		case <-ctx.Done():
+			return timeoutError(ctx)
+		}
+	}
+
+	// the handler sets the response fields of its own copy,
+	// not to race with the abandoned request
+	r := *req
+	errc := make(chan error, 1)
+	go func() { errc <- rh(ctx, &r) }()
+	select {
+	case err := <-errc:
+		*req = r
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			// e.g. gRPC "DeadlineExceeded" from etcd
+			return errRequestTimeout
+		}
+		return err
+	case <-ctx.Done():
+		*abandoned = errc
+		return timeoutError(ctx)
+	}
+}
+
+// timeoutError returns errRequestTimeout on the deadline,
+// or the cancel error of the run.
+func timeoutError(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return errRequestTimeout
+	}
+	return ctx.Err()
+}
+
+// doRetry runs the request until it succeeds or the retry policy
+// gives up, and returns the number of attempts.
+func (b *benchmark) doRetry(rh ReqHandler, req *Request, abandoned *chan error) (attempts int64, err error) {
+	for attempts = 1; ; attempts++ {
+		err = b.do(rh, req, abandoned)
+		if err == nil || !b.retry.retry(attempts, err) || b.ctx.Err() != nil {
+			return attempts, err
+		}
+		select {
+		case <-time.After(b.retry.backoffAfter(attempts)):
+		case <-b.ctx.Done():
+			return attempts, err
+		}
+	}
+}
+
+func (b *benchmark) getOpReport(op Op) *latencyRecorder {
+	b.opMu.Lock()
+	defer b.opMu.Unlock()
+	r, ok := b.opReports[op]
+	if !ok {
+		r = &opReport{report: newLatencyRecorder(b.phases, b.begin)}
+		r.reportDone = r.report.Stats()
+		b.opReports[op] = r
+	}
+	return r.report
+}
+
+func (b *benchmark) getUncorrectedReport() *latencyRecorder {
+	b.opMu.Lock()
+	defer b.opMu.Unlock()
+	if b.uncorrected == nil {
+		b.uncorrected = &opReport{report: newLatencyRecorder(b.phases, b.begin)}
+		b.uncorrected.reportDone = b.uncorrected.report.Stats()
+	}
+	return b.uncorrected.report
+}
+
+func (b *benchmark) getRetriedReport() *latencyRecorder {
+	b.opMu.Lock()
+	defer b.opMu.Unlock()
+	if b.retried == nil {
+		b.retried = &opReport{report: newLatencyRecorder(b.phases, b.begin)}
+		b.retried.reportDone = b.retried.report.Stats()
+	}
+	return b.retried.report
+}
+
 func (b *benchmark) waitRequestsEnd() {
 	b.wg.Wait()
+	b.liveMetrics.finish()
 	if b.reqDone != nil {
 		b.reqDone() // cancel connections
 	}
@@ -111,6 +362,32 @@ func (b *benchmark) finishReports() {
 	b.bar.Finish()
 	st := <-b.reportDone
 	b.stats = st
+
+	b.opMu.Lock()
+	b.opStats = make(map[Op]latencyStats, len(b.opReports))
+	for op, r := range b.opReports {
+		close(r.report.Results())
+		b.opStats[op] = <-r.reportDone
+	}
+	if b.uncorrected != nil {
+		close(b.uncorrected.report.Results())
+		st := <-b.uncorrected.reportDone
+		b.uncorrectedStats = &st
+	}
+	if b.retry.enabled() {
+		b.retryStats = &retryStats{
+			requests: b.requests,
+			attempts: b.attempts,
+			retried:  b.retriedN,
+			failed:   b.failedN,
+		}
+		if b.retried != nil {
+			close(b.retried.report.Results())
+			st := <-b.retried.reportDone
+			b.retryStats.latency = &st
+		}
+	}
+	b.opMu.Unlock()
 }
 
 func (b *benchmark) waitAll() {
@@ -118,29 +395,185 @@ func (b *benchmark) waitAll() {
 	b.finishReports()
 }
 
-func printStats(st report.Stats) {
+// extraStats are the stats collected in addition to latencyStats.
+type extraStats struct {
+	opStats   map[Op]latencyStats
+	respBytes respBytesStats
+	watch     *watchStats
+	lease     *leaseStats
+	conflicts int64
+	// uncorrected are the stats from the actual send time
+	// in open-loop mode, while the main stats are corrected
+	// to the scheduled send time.
+	uncorrected *latencyStats
+	// retry are the stats of the requests with retries,
+	// nil without retry policy.
+	retry *retryStats
+	// interrupted is true when the run is canceled
+	// before all requests are sent.
+	interrupted bool
+}
+
+// merge merges the stats of the benchmark, which runs a range of
+// client numbers. The merged latency stats are updated by 'update'.
+func (ext *extraStats) merge(b *benchmark) {
+	if ext.opStats == nil {
+		ext.opStats = make(map[Op]latencyStats, len(b.opStats))
+	}
+	for op, st := range b.opStats {
+		merged, ok := ext.opStats[op]
+		if !ok {
+			merged = newLatencyStats()
+		}
+		merged.merge(st)
+		ext.opStats[op] = merged
+	}
+	ext.respBytes.merge(b.respBytes)
+	ext.conflicts += b.conflicts
+	if b.uncorrectedStats != nil {
+		if ext.uncorrected == nil {
+			st := newLatencyStats()
+			ext.uncorrected = &st
+		}
+		ext.uncorrected.merge(*b.uncorrectedStats)
+	}
+	if b.retryStats != nil {
+		if ext.retry == nil {
+			ext.retry = &retryStats{}
+		}
+		ext.retry.merge(b.retryStats)
+	}
+}
+
+// update updates the merged latency stats.
+func (ext *extraStats) update() {
+	for op, st := range ext.opStats {
+		st.update()
+		ext.opStats[op] = st
+	}
+	if ext.uncorrected != nil {
+		ext.uncorrected.update()
+	}
+	if ext.retry != nil && ext.retry.latency != nil {
+		ext.retry.latency.update()
+	}
+}
+
+// requestTimeout returns the timeout of each request, 0 for no timeout.
+func requestTimeout(gcfg dbtesterpb.ConfigClientMachineAgentControl) time.Duration {
+	return time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.RequestTimeoutMilliseconds) * time.Millisecond
+}
+
+// completedRequests returns the number of requests completed
+// with or without errors, including warm-up and cool-down.
+func completedRequests(st latencyStats) int64 {
+	return st.completed() + st.warmup + st.cooldown
+}
+
+func printStats(st latencyStats) {
 	// to be piped to cfg.Log via stdout when dbtester executed
-	if len(st.Lats) > 0 {
+	if st.count() > 0 {
 		fmt.Printf("Total: %v\n", st.Total)
 		fmt.Printf("Slowest: %f secs\n", st.Slowest)
 		fmt.Printf("Fastest: %f secs\n", st.Fastest)
 		fmt.Printf("Average: %f secs\n", st.Average)
 		fmt.Printf("Requests/sec: %4.4f\n", st.RPS)
+		fmt.Printf("P99.99: %f secs\n", st.percentile(99.99))
 	}
 	if len(st.ErrorDist) > 0 {
-		for k, v := range st.ErrorDist {
-			fmt.Printf("ERROR %q : %d\n", k, v)
+		for _, category := range st.errorSamples.categories() {
+			fmt.Printf("ERROR %q : %d (e.g. %q)\n", category, st.ErrorDist[category], st.errorSamples[category][0])
 		}
 	} else {
 		fmt.Println("ERRRO: 0")
 	}
 }
 
-func (cfg *Config) generateReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
-	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, reqDone, reqGen)
+func printRetryStats(rs *retryStats) {
+	if rs == nil {
+		return
+	}
+	fmt.Printf("Attempts/request: %4.4f\n", rs.attemptsPerRequest())
+	fmt.Printf("Retried requests: %d\n", rs.retried)
+	fmt.Printf("Eventually failed requests: %d\n", rs.failed)
+	if rs.latency != nil && rs.latency.count() > 0 {
+		fmt.Printf("Retried average: %f secs\n", rs.latency.Average)
+		fmt.Printf("Retried slowest: %f secs\n", rs.latency.Slowest)
+	}
+}
+
+// printOpStats prints stats of each operation kind,
+// only when requests have more than one kind.
+func printOpStats(opStats map[Op]latencyStats) {
+	if len(opStats) < 2 {
+		return
+	}
+	for _, op := range sortedOps(opStats) {
+		st := opStats[op]
+		fmt.Printf("[%s] Total requests: %d\n", op, st.count())
+		if st.count() > 0 {
+			fmt.Printf("[%s] Slowest: %f secs\n", op, st.Slowest)
+			fmt.Printf("[%s] Fastest: %f secs\n", op, st.Fastest)
+			fmt.Printf("[%s] Average: %f secs\n", op, st.Average)
+			fmt.Printf("[%s] Requests/sec: %4.4f\n", op, st.RPS)
+		}
+		for _, category := range st.errorSamples.categories() {
+			fmt.Printf("[%s] ERROR %q : %d\n", op, category, st.ErrorDist[category])
+		}
+	}
+}
+
+func sortedOps(opStats map[Op]latencyStats) []Op {
+	ops := make([]Op, 0, len(opStats))
+	for op := range opStats {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i] < ops[j] })
+	return ops
+}
+
+// generateReport runs the requests, saves the results,
+// and returns the stats of all requests.
+func (cfg *Config) generateReport(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(context.Context, chan<- Request)) latencyStats {
+	b := newBenchmark(ctx, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions), requestTimeout(gcfg), newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions), h, reqDone, reqGen)
+	b.history = cfg.history
+	b.written = cfg.written
+	b.trace = cfg.trace
+	cfg.waitStart(ctx)
+	cfg.liveMetrics.begin(b, gcfg)
 	b.startRequests()
 	b.waitAll()
+	if b.history != nil {
+		cfg.saveHistory(b.history)
+	}
 
+	ext := extraStats{
+		opStats:     b.opStats,
+		respBytes:   b.respBytes,
+		conflicts:   b.conflicts,
+		uncorrected: b.uncorrectedStats,
+		retry:       b.retryStats,
+		interrupted: ctx.Err() != nil,
+	}
 	printStats(b.stats)
-	cfg.saveAllStats(gcfg, b.stats, nil)
+	printExtraStats(ext)
+	cfg.saveAllStats(gcfg, b.stats, nil, ext)
+	return b.stats
+}
+
+// printExtraStats prints the stats other than the latency of all requests.
+func printExtraStats(ext extraStats) {
+	printOpStats(ext.opStats)
+	if ext.respBytes.total > 0 {
+		fmt.Printf("Response bytes: %d\n", ext.respBytes.total)
+	}
+	if st, ok := ext.opStats[OpTxn]; ok {
+		fmt.Printf("Txn succeeded: %d\n", st.count()-ext.conflicts)
+		fmt.Printf("Txn conflicts: %d\n", ext.conflicts)
+	}
+	if ext.uncorrected != nil {
+		fmt.Printf("Uncorrected slowest: %f secs\n", ext.uncorrected.Slowest)
+		fmt.Printf("Uncorrected average: %f secs\n", ext.uncorrected.Average)
+	}
+	printRetryStats(ext.retry)
 }
@@ -17,6 +17,7 @@ package dbtester
 import (
 	"fmt"
 	"math"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -72,7 +73,7 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	return fr.CSV(cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
 }
 
-func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
+func (cfg *Config) saveDataLatencyDistributionSummary(gcfg dbtesterpb.ConfigClientMachineAgentControl, st latencyStats, ext extraStats) {
 	fr := dataframe.New()
 
 	c1 := dataframe.NewColumn("TOTAL-SECONDS")
@@ -87,6 +88,13 @@ func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 		panic(err)
 	}
 
+	// requests may end before 'RequestNumber' on the deadline
+	ctr := dataframe.NewColumn("TOTAL-REQUESTS")
+	ctr.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", completedRequests(st))))
+	if err := fr.AddColumn(ctr); err != nil {
+		panic(err)
+	}
+
 	c3 := dataframe.NewColumn("SLOWEST-LATENCY-MS")
 	c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*st.Slowest)))
 	if err := fr.AddColumn(c3); err != nil {
@@ -112,9 +120,11 @@ func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 	}
 
 	if len(st.ErrorDist) > 0 {
-		for errName, errN := range st.ErrorDist {
-			errcol := dataframe.NewColumn(fmt.Sprintf("ERROR: %q", errName))
-			errcol.PushBack(dataframe.NewStringValue(errN))
+		// one column per error category (e.g. 'ERROR: "timeout"'),
+		// the raw messages are in the error samples file
+		for _, category := range st.errorSamples.categories() {
+			errcol := dataframe.NewColumn(fmt.Sprintf("ERROR: %q", category))
+			errcol.PushBack(dataframe.NewStringValue(st.ErrorDist[category]))
 			if err := fr.AddColumn(errcol); err != nil {
 				panic(err)
 			}
@@ -127,23 +137,243 @@ func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 		}
 	}
 
+	if ext.respBytes.total > 0 {
+		c7 := dataframe.NewColumn("TOTAL-RESPONSE-BYTES")
+		c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", ext.respBytes.total)))
+		if err := fr.AddColumn(c7); err != nil {
+			panic(err)
+		}
+		c8 := dataframe.NewColumn("AVERAGE-RESPONSE-BYTES")
+		c8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", float64(ext.respBytes.total)/float64(ext.respBytes.requests))))
+		if err := fr.AddColumn(c8); err != nil {
+			panic(err)
+		}
+	}
+
+	if txn, ok := ext.opStats[OpTxn]; ok {
+		// conflicts are successful requests that did not update the key
+		c9 := dataframe.NewColumn("TXN-SUCCESS")
+		c9.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", txn.count()-ext.conflicts)))
+		if err := fr.AddColumn(c9); err != nil {
+			panic(err)
+		}
+		c10 := dataframe.NewColumn("TXN-CONFLICT")
+		c10.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", ext.conflicts)))
+		if err := fr.AddColumn(c10); err != nil {
+			panic(err)
+		}
+	}
+
+	if ext.watch != nil {
+		for _, kv := range []struct {
+			name  string
+			value string
+		}{
+			{"WATCHERS", fmt.Sprintf("%d", ext.watch.watchers)},
+			{"WATCH-EXPECTED-EVENTS", fmt.Sprintf("%d", ext.watch.expected)},
+			{"WATCH-RECEIVED-EVENTS", fmt.Sprintf("%d", ext.watch.received)},
+			{"WATCH-MISSED-EVENTS", fmt.Sprintf("%d", ext.watch.missed)},
+			{"WATCH-DUPLICATED-EVENTS", fmt.Sprintf("%d", ext.watch.duplicated)},
+			{"WATCH-WRITE-REQUESTS-PER-SECOND", fmt.Sprintf("%4.4f", ext.watch.writes.RPS)},
+			{"WATCH-WRITE-AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*ext.watch.writes.Average)},
+		} {
+			col := dataframe.NewColumn(kv.name)
+			col.PushBack(dataframe.NewStringValue(kv.value))
+			if err := fr.AddColumn(col); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if ext.lease != nil {
+		for _, kv := range []struct {
+			name  string
+			value string
+		}{
+			{"LEASES", fmt.Sprintf("%d", ext.lease.leases)},
+			{"LEASE-EXPIRED", fmt.Sprintf("%d", ext.lease.expired)},
+			{"LEASE-NOT-EXPIRED", fmt.Sprintf("%d", ext.lease.notExpired)},
+			{"LEASE-EXPIRY-DELAY-MIN-MS", fmt.Sprintf("%4.4f", ext.lease.expiryDelay(0))},
+			{"LEASE-EXPIRY-DELAY-AVERAGE-MS", fmt.Sprintf("%4.4f", ext.lease.averageExpiryDelay())},
+			{"LEASE-EXPIRY-DELAY-P50-MS", fmt.Sprintf("%4.4f", ext.lease.expiryDelay(50))},
+			{"LEASE-EXPIRY-DELAY-P99-MS", fmt.Sprintf("%4.4f", ext.lease.expiryDelay(99))},
+			{"LEASE-EXPIRY-DELAY-MAX-MS", fmt.Sprintf("%4.4f", ext.lease.expiryDelay(100))},
+		} {
+			col := dataframe.NewColumn(kv.name)
+			col.PushBack(dataframe.NewStringValue(kv.value))
+			if err := fr.AddColumn(col); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// open-loop latency from the scheduled (corrected)
+	// and the actual (uncorrected) send time
+	if ext.uncorrected != nil {
+		for _, v := range []struct {
+			prefix string
+			st     latencyStats
+		}{
+			{"CORRECTED", st},
+			{"UNCORRECTED", *ext.uncorrected},
+		} {
+			kvs := [][2]string{
+				{"AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*v.st.Average)},
+				{"SLOWEST-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*v.st.Slowest)},
+			}
+			for _, pct := range latencyPercentiles {
+				name := strings.ToUpper(percentileName(pct)) + "-LATENCY-MS"
+				kvs = append(kvs, [2]string{name, fmt.Sprintf("%4.4f", 1000*v.st.percentile(pct))})
+			}
+			for _, kv := range kvs {
+				col := dataframe.NewColumn(v.prefix + "-" + kv[0])
+				col.PushBack(dataframe.NewStringValue(kv[1]))
+				if err := fr.AddColumn(col); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+
+	// requests with retries, the latency includes all attempts and backoffs
+	if ext.retry != nil {
+		kvs := [][2]string{
+			{"ATTEMPTS-PER-REQUEST", fmt.Sprintf("%4.4f", ext.retry.attemptsPerRequest())},
+			{"RETRIED-REQUESTS", fmt.Sprintf("%d", ext.retry.retried)},
+			{"EVENTUALLY-FAILED-REQUESTS", fmt.Sprintf("%d", ext.retry.failed)},
+		}
+		if rst := ext.retry.latency; rst != nil {
+			kvs = append(kvs,
+				[2]string{"RETRIED-AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*rst.Average)},
+				[2]string{"RETRIED-P99-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*rst.percentile(99))},
+				[2]string{"RETRIED-SLOWEST-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*rst.Slowest)},
+			)
+		}
+		for _, kv := range kvs {
+			col := dataframe.NewColumn(kv[0])
+			col.PushBack(dataframe.NewStringValue(kv[1]))
+			if err := fr.AddColumn(col); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// per operation kind summary (e.g. "GET-REQUESTS-PER-SECOND")
+	// only when requests have more than one kind
+	if len(ext.opStats) > 1 {
+		for _, op := range sortedOps(ext.opStats) {
+			ost := ext.opStats[op]
+			errN := 0
+			for _, v := range ost.ErrorDist {
+				errN += v
+			}
+			for _, kv := range []struct {
+				name  string
+				value string
+			}{
+				{"REQUESTS", fmt.Sprintf("%d", ost.count()+int64(errN))},
+				{"REQUESTS-PER-SECOND", fmt.Sprintf("%4.4f", ost.RPS)},
+				{"SLOWEST-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*ost.Slowest)},
+				{"FASTEST-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*ost.Fastest)},
+				{"AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*ost.Average)},
+				{"STDDEV-LATENCY-MS", fmt.Sprintf("%4.4f", 1000*ost.Stddev)},
+				{"ERROR", fmt.Sprintf("%d", errN)},
+			} {
+				col := dataframe.NewColumn(fmt.Sprintf("%s-%s", op, kv.name))
+				col.PushBack(dataframe.NewStringValue(kv.value))
+				if err := fr.AddColumn(col); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+
+	// key and value settings to reproduce the run
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	settingCols := [][2]string{{"RANDOM-SEED", fmt.Sprintf("%d", opts.RandomSeed)}}
+	if opts.KeyDistribution != "" {
+		settingCols = append(settingCols,
+			[2]string{"KEY-DISTRIBUTION", opts.KeyDistribution},
+			[2]string{"KEY-SPACE-SIZE", fmt.Sprintf("%d", opts.KeySpaceSize)},
+		)
+	}
+	switch opts.KeyDistribution {
+	case keyDistributionZipfian, keyDistributionLatest:
+		settingCols = append(settingCols, [2]string{"ZIPFIAN-SKEW", fmt.Sprintf("%4.4f", opts.ZipfianSkew)})
+	case keyDistributionHotspot:
+		settingCols = append(settingCols,
+			[2]string{"HOTSPOT-KEY-PERCENT", fmt.Sprintf("%d", opts.HotspotKeyPercent)},
+			[2]string{"HOTSPOT-REQUEST-PERCENT", fmt.Sprintf("%d", opts.HotspotRequestPercent)},
+		)
+	}
+	if newLatencyPhases(opts).enabled() {
+		settingCols = append(settingCols,
+			[2]string{"WARMUP-SECONDS", fmt.Sprintf("%d", opts.WarmupSeconds)},
+			[2]string{"WARMUP-REQUEST-NUMBER", fmt.Sprintf("%d", opts.WarmupRequests)},
+			[2]string{"COOLDOWN-SECONDS", fmt.Sprintf("%d", opts.CooldownSeconds)},
+			[2]string{"COOLDOWN-REQUEST-NUMBER", fmt.Sprintf("%d", opts.CooldownRequests)},
+			[2]string{"WARMUP-EXCLUDED-REQUESTS", fmt.Sprintf("%d", st.warmup)},
+			[2]string{"COOLDOWN-EXCLUDED-REQUESTS", fmt.Sprintf("%d", st.cooldown)},
+		)
+	}
+	if opts.ValueSizeDistribution != "" || opts.ValuePoolSize > 1 || opts.ValueCompressibility > 0 {
+		settingCols = append(settingCols,
+			[2]string{"VALUE-SIZE-DISTRIBUTION", opts.ValueSizeDistribution},
+			[2]string{"VALUE-POOL-SIZE", fmt.Sprintf("%d", opts.ValuePoolSize)},
+			[2]string{"VALUE-COMPRESSIBILITY", fmt.Sprintf("%4.4f", opts.ValueCompressibility)},
+		)
+	}
+	switch opts.ValueSizeDistribution {
+	case valueSizeUniform:
+		settingCols = append(settingCols,
+			[2]string{"VALUE-SIZE-MIN-BYTES", fmt.Sprintf("%d", opts.ValueSizeMinBytes)},
+			[2]string{"VALUE-SIZE-MAX-BYTES", fmt.Sprintf("%d", opts.ValueSizeMaxBytes)},
+		)
+	case valueSizeNormal:
+		settingCols = append(settingCols, [2]string{"VALUE-SIZE-STDDEV-BYTES", fmt.Sprintf("%d", opts.ValueSizeStddevBytes)})
+	case valueSizeHistogram:
+		settingCols = append(settingCols, [2]string{"VALUE-SIZE-HISTOGRAM", opts.ValueSizeHistogramPath})
+	}
+	if opts.RequestTimeoutMilliseconds > 0 {
+		settingCols = append(settingCols, [2]string{"REQUEST-TIMEOUT-MS", fmt.Sprintf("%d", opts.RequestTimeoutMilliseconds)})
+	}
+	if newRetryPolicy(opts).enabled() {
+		settingCols = append(settingCols,
+			[2]string{"RETRY-MAX-ATTEMPTS", fmt.Sprintf("%d", opts.RetryMaxAttempts)},
+			[2]string{"RETRY-BACKOFF-MS", fmt.Sprintf("%d", opts.RetryBackoffMilliseconds)},
+			[2]string{"RETRY-MAX-BACKOFF-MS", fmt.Sprintf("%d", opts.RetryMaxBackoffMilliseconds)},
+			[2]string{"RETRY-ERROR-CATEGORIES", strings.Join(retryErrorCategories(opts), " ")},
+		)
+	}
+	if profile := loadProfile(gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile); len(profile) > 0 {
+		settingCols = append(settingCols, [2]string{"LOAD-PROFILE", profile.String()})
+	}
+	if n := len(gcfg.LoadGeneratorEndpoints); n > 0 {
+		settingCols = append(settingCols, [2]string{"LOAD-GENERATORS", fmt.Sprintf("%d", n)})
+	}
+	if ext.interrupted {
+		// the stats are partial, of the requests before the interrupt
+		settingCols = append(settingCols, [2]string{"INTERRUPTED", "true"})
+	}
+	for _, kv := range settingCols {
+		col := dataframe.NewColumn(kv[0])
+		col.PushBack(dataframe.NewStringValue(kv[1]))
+		if err := fr.AddColumn(col); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath); err != nil {
 		panic(err)
 	}
 }
 
-func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
-	pctls, seconds := report.Percentiles(st.Lats)
+func (cfg *Config) saveDataLatencyDistributionPercentile(st latencyStats) {
 	c1 := dataframe.NewColumn("LATENCY-PERCENTILE")
 	c2 := dataframe.NewColumn("LATENCY-MS")
-	for i := range pctls {
-		pct := fmt.Sprintf("p%.1f", pctls[i])
-		if strings.HasSuffix(pct, ".0") {
-			pct = strings.Replace(pct, ".0", "", -1)
-		}
-
-		c1.PushBack(dataframe.NewStringValue(pct))
-		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*seconds[i])))
+	for _, pct := range latencyPercentiles {
+		c1.PushBack(dataframe.NewStringValue(percentileName(pct)))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*st.percentile(pct))))
 	}
 
 	fr := dataframe.New()
@@ -158,22 +388,60 @@ func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
 	}
 }
 
-func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
+// saveDataLatencyHistogram saves the latency histogram of all requests,
+// to be merged with other runs (see 'latencyHistogram.Encode').
+func (cfg *Config) saveDataLatencyHistogram(st latencyStats) {
+	f, err := os.Create(LatencyHistogramPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath))
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	if err = st.hist.Encode(f); err != nil {
+		panic(err)
+	}
+}
+
+// saveDataErrorSamples saves the distinct raw messages
+// of each error category in the summary.
+func (cfg *Config) saveDataErrorSamples(st latencyStats) {
+	c1 := dataframe.NewColumn("CATEGORY")
+	c2 := dataframe.NewColumn("COUNT")
+	c3 := dataframe.NewColumn("SAMPLE-MESSAGE")
+	for _, category := range st.errorSamples.categories() {
+		for _, msg := range st.errorSamples[category] {
+			c1.PushBack(dataframe.NewStringValue(category))
+			c2.PushBack(dataframe.NewStringValue(st.ErrorDist[category]))
+			c3.PushBack(dataframe.NewStringValue(msg))
+		}
+	}
+
+	fr := dataframe.New()
+	if err := fr.AddColumn(c1); err != nil {
+		panic(err)
+	}
+	if err := fr.AddColumn(c2); err != nil {
+		panic(err)
+	}
+	if err := fr.AddColumn(c3); err != nil {
+		panic(err)
+	}
+	if err := fr.CSV(ErrorSamplesPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+		panic(err)
+	}
+}
+
+func (cfg *Config) saveDataLatencyDistributionAll(st latencyStats) {
 	min := int64(math.MaxInt64)
 	max := int64(-100000)
 	rm := make(map[int64]int64)
-	for _, lt := range st.Lats {
-		// convert second(float64) to millisecond
-		ms := lt * 1000
+	for _, b := range st.hist.Buckets() {
+		// convert microsecond to millisecond
+		ms := float64(b.From) / 1000
 
 		// truncate all digits below 10ms
 		// (e.g. 125.11ms becomes 120ms)
 		v := int64(math.Trunc(ms/10) * 10)
-		if _, ok := rm[v]; !ok {
-			rm[v] = 1
-		} else {
-			rm[v]++
-		}
+		rm[v] += b.Count
 
 		if min > v {
 			min = v
@@ -211,7 +479,7 @@ func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
 	}
 }
 
-func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats, clientNs []int64) {
+func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st latencyStats, clientNs []int64, ext extraStats) {
 	if len(clientNs) == 0 && len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
 		clientNs = make([]int64, len(st.TimeSeries))
 		for i := range clientNs {
@@ -224,6 +492,7 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	c4 := dataframe.NewColumn("AVG-LATENCY-MS")
 	c5 := dataframe.NewColumn("MAX-LATENCY-MS")
 	c6 := dataframe.NewColumn("AVG-THROUGHPUT")
+	c6p99 := dataframe.NewColumn("P99-LATENCY-MS")
 	for i := range st.TimeSeries {
 		// this Timestamp is unix seconds
 		c1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].Timestamp)))
@@ -232,6 +501,7 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].AvgLatency))))
 		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].MaxLatency))))
 		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].ThroughPut)))
+		c6p99.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.secondPercentile(st.TimeSeries[i].Timestamp, 99)))))
 	}
 
 	fr := dataframe.New()
@@ -253,13 +523,87 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	if err := fr.AddColumn(c6); err != nil {
 		panic(err)
 	}
+	if err := fr.AddColumn(c6p99); err != nil {
+		panic(err)
+	}
+	c6e := dataframe.NewColumn("ERROR-RATE")
+	for i := range st.TimeSeries {
+		c6e.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", st.secondErrorRate(st.TimeSeries[i].Timestamp))))
+	}
+	if err := fr.AddColumn(c6e); err != nil {
+		panic(err)
+	}
+
+	// the target rate at the middle of each second, from the first request sent
+	if profile := loadProfile(gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile); len(profile) > 0 {
+		ct := dataframe.NewColumn("TARGET-RATE")
+		for i := range st.TimeSeries {
+			mid := time.Unix(st.TimeSeries[i].Timestamp, 0).Add(time.Second / 2)
+			ct.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", profile.rate(mid.Sub(st.begin)))))
+		}
+		if err := fr.AddColumn(ct); err != nil {
+			panic(err)
+		}
+	}
+
+	// requests in warm-up and cool-down are not in the other results
+	if newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions).enabled() {
+		cp := dataframe.NewColumn("PHASE")
+		for i := range st.TimeSeries {
+			cp.PushBack(dataframe.NewStringValue(st.secondPhase(st.TimeSeries[i].Timestamp)))
+		}
+		if err := fr.AddColumn(cp); err != nil {
+			panic(err)
+		}
+	}
+
+	if ext.respBytes.total > 0 {
+		c7 := dataframe.NewColumn("AVG-RESPONSE-BYTES")
+		for i := range st.TimeSeries {
+			avg := float64(0)
+			if st.TimeSeries[i].ThroughPut > 0 {
+				avg = float64(ext.respBytes.bySecond[st.TimeSeries[i].Timestamp]) / float64(st.TimeSeries[i].ThroughPut)
+			}
+			c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", avg)))
+		}
+		if err := fr.AddColumn(c7); err != nil {
+			panic(err)
+		}
+	}
+
+	// per operation kind latency and throughput (e.g. "GET-AVG-THROUGHPUT"),
+	// aligned with the unix seconds of all requests
+	if len(ext.opStats) > 1 {
+		for _, op := range sortedOps(ext.opStats) {
+			tsm := make(map[int64]report.DataPoint)
+			for _, dp := range ext.opStats[op].TimeSeries {
+				tsm[dp.Timestamp] = dp
+			}
+			oc1 := dataframe.NewColumn(fmt.Sprintf("%s-MIN-LATENCY-MS", op))
+			oc2 := dataframe.NewColumn(fmt.Sprintf("%s-AVG-LATENCY-MS", op))
+			oc3 := dataframe.NewColumn(fmt.Sprintf("%s-MAX-LATENCY-MS", op))
+			oc4 := dataframe.NewColumn(fmt.Sprintf("%s-AVG-THROUGHPUT", op))
+			for i := range st.TimeSeries {
+				dp := tsm[st.TimeSeries[i].Timestamp]
+				oc1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(dp.MinLatency))))
+				oc2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(dp.AvgLatency))))
+				oc3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(dp.MaxLatency))))
+				oc4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", dp.ThroughPut)))
+			}
+			for _, col := range []dataframe.Column{oc1, oc2, oc3, oc4} {
+				if err := fr.AddColumn(col); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
 
 	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
 		panic(err)
 	}
 
 	// aggregate latency by the number of keys
-	tss := FindRangesLatency(st.TimeSeries, 1000, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	tss := FindRangesLatency(st.TimeSeries, 1000, completedRequests(st))
 	ctt1 := dataframe.NewColumn("KEYS")
 	ctt2 := dataframe.NewColumn("MIN-LATENCY-MS")
 	ctt3 := dataframe.NewColumn("AVG-LATENCY-MS")
@@ -290,11 +634,18 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	}
 }
 
-func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats report.Stats, clientNs []int64) {
-	cfg.saveDataLatencyDistributionSummary(stats)
+func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats latencyStats, clientNs []int64, ext extraStats) {
+	if cfg.part != nil {
+		// the control machine merges the stats of all load generators
+		cfg.part.save(stats, clientNs, ext)
+		return
+	}
+	cfg.saveDataLatencyDistributionSummary(gcfg, stats, ext)
 	cfg.saveDataLatencyDistributionPercentile(stats)
+	cfg.saveDataLatencyHistogram(stats)
+	cfg.saveDataErrorSamples(stats)
 	cfg.saveDataLatencyDistributionAll(stats)
-	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, clientNs)
+	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, clientNs, ext)
 }
 
 // UploadToGoogle uploads target file to Google Cloud Storage.
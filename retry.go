@@ -0,0 +1,153 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// defaultRetryErrorCategories are the transient errors
+// to retry when no category is configured.
+var defaultRetryErrorCategories = []string{ErrorTimeout, ErrorUnavailable, ErrorNoLeader, ErrorConnectionReset}
+
+var errorCategories = map[string]struct{}{
+	ErrorTimeout:         {},
+	ErrorUnavailable:     {},
+	ErrorNoLeader:        {},
+	ErrorConflict:        {},
+	ErrorQuotaExceeded:   {},
+	ErrorNotFound:        {},
+	ErrorConnectionReset: {},
+	ErrorOther:           {},
+}
+
+// retryPolicy retries the failed requests of the retriable error
+// categories with exponential backoff.
+type retryPolicy struct {
+	maxAttempts int64
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	categories  map[string]struct{}
+}
+
+func newRetryPolicy(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) retryPolicy {
+	p := retryPolicy{
+		maxAttempts: opts.RetryMaxAttempts,
+		backoff:     time.Duration(opts.RetryBackoffMilliseconds) * time.Millisecond,
+		maxBackoff:  time.Duration(opts.RetryMaxBackoffMilliseconds) * time.Millisecond,
+		categories:  make(map[string]struct{}),
+	}
+	for _, c := range retryErrorCategories(opts) {
+		p.categories[c] = struct{}{}
+	}
+	return p
+}
+
+// retryErrorCategories returns the configured categories,
+// or the default ones if empty.
+func retryErrorCategories(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) []string {
+	if len(opts.RetryErrorCategories) == 0 {
+		return defaultRetryErrorCategories
+	}
+	return opts.RetryErrorCategories
+}
+
+// validateRetryErrorCategories returns an error on unknown categories.
+func validateRetryErrorCategories(categories []string) error {
+	for _, c := range categories {
+		if _, ok := errorCategories[c]; !ok {
+			known := make([]string, 0, len(errorCategories))
+			for k := range errorCategories {
+				known = append(known, k)
+			}
+			sort.Strings(known)
+			return fmt.Errorf("unknown error category %q (expected one of %s)", c, strings.Join(known, ", "))
+		}
+	}
+	return nil
+}
+
+func (p retryPolicy) enabled() bool {
+	return p.maxAttempts > 1
+}
+
+// retry returns true if the request should be attempted again
+// after 'attempts' failed attempts.
+func (p retryPolicy) retry(attempts int64, err error) bool {
+	if attempts >= p.maxAttempts {
+		return false
+	}
+	_, ok := p.categories[errorCategory(err)]
+	return ok
+}
+
+// backoffAfter returns the wait after 'attempts' failed attempts,
+// doubled on each retry.
+func (p retryPolicy) backoffAfter(attempts int64) time.Duration {
+	d := p.backoff
+	for i := int64(1); i < attempts; i++ {
+		if p.maxBackoff > 0 && d >= p.maxBackoff {
+			break
+		}
+		d *= 2
+	}
+	if p.maxBackoff > 0 && d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+	return d
+}
+
+// retryStats are the stats of the requests with retries.
+type retryStats struct {
+	// requests is the number of requests, with or without retries.
+	requests int64
+	// attempts is the number of attempts of all requests.
+	attempts int64
+	// retried is the number of requests attempted more than once.
+	retried int64
+	// failed is the number of retried requests failed after all attempts.
+	failed int64
+	// latency is the latency of the retried requests,
+	// from the first attempt to the end of the last one.
+	latency *latencyStats
+}
+
+func (rs *retryStats) merge(other *retryStats) {
+	rs.requests += other.requests
+	rs.attempts += other.attempts
+	rs.retried += other.retried
+	rs.failed += other.failed
+	if other.latency == nil {
+		return
+	}
+	if rs.latency == nil {
+		st := newLatencyStats()
+		rs.latency = &st
+	}
+	rs.latency.merge(*other.latency)
+}
+
+// attemptsPerRequest returns the average number of attempts.
+func (rs *retryStats) attemptsPerRequest() float64 {
+	if rs.requests == 0 {
+		return 0
+	}
+	return float64(rs.attempts) / float64(rs.requests)
+}
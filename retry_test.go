@@ -0,0 +1,59 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func Test_retryPolicy(t *testing.T) {
+	p := newRetryPolicy(&dbtesterpb.ConfigClientMachineBenchmarkOptions{
+		RetryMaxAttempts:            4,
+		RetryBackoffMilliseconds:    10,
+		RetryMaxBackoffMilliseconds: 30,
+	})
+	if !p.enabled() {
+		t.Fatal("expected retry policy enabled")
+	}
+	for i, expected := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 30 * time.Millisecond} {
+		if d := p.backoffAfter(int64(i + 1)); d != expected {
+			t.Fatalf("#%d: expected backoff %v, got %v", i, expected, d)
+		}
+	}
+
+	unavailable := &categorizedError{category: ErrorUnavailable, err: errors.New("unavailable")}
+	if !p.retry(1, unavailable) || !p.retry(3, unavailable) || p.retry(4, unavailable) {
+		t.Fatal("expected unavailable error retried up to 4 attempts")
+	}
+	if p.retry(1, &categorizedError{category: ErrorNotFound, err: errors.New("not found")}) {
+		t.Fatal("expected not-found error not retried by default")
+	}
+
+	p = newRetryPolicy(&dbtesterpb.ConfigClientMachineBenchmarkOptions{RetryMaxAttempts: 2, RetryErrorCategories: []string{ErrorConflict}})
+	if p.retry(1, unavailable) || !p.retry(1, &categorizedError{category: ErrorConflict, err: errors.New("conflict")}) {
+		t.Fatal("expected only conflict error retried")
+	}
+	if p = newRetryPolicy(&dbtesterpb.ConfigClientMachineBenchmarkOptions{RetryMaxAttempts: 1}); p.enabled() {
+		t.Fatal("expected retry policy disabled with 1 attempt")
+	}
+
+	if err := validateRetryErrorCategories([]string{ErrorTimeout, "no-such-category"}); err == nil {
+		t.Fatal("expected error on unknown category")
+	}
+}
@@ -16,17 +16,14 @@ package dbtester
 
 import (
 	"fmt"
-	"math"
+	mrand "math/rand"
 	"os"
-	"sort"
+	"os/signal"
 	"sync"
 	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
 
-	"go.etcd.io/etcd/client/v3"
-
-	"github.com/etcd-io/etcd/pkg/report"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
 	"golang.org/x/time/rate"
@@ -34,50 +31,268 @@ import (
 
 type values struct {
 	bytes      [][]byte
-	strings    []string
 	sampleSize int
 }
 
-func newValues(gcfg dbtesterpb.ConfigClientMachineAgentControl) (v values, rerr error) {
-	v.bytes = [][]byte{randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)}
-	v.strings = []string{string(v.bytes[0])}
-	v.sampleSize = 1
+// newValues returns the pool of 'ValuePoolSize' distinct values,
+// with the sizes drawn from 'ValueSizeDistribution'.
+func newValues(gcfg dbtesterpb.ConfigClientMachineAgentControl, rnd *mrand.Rand) (v values, rerr error) {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if (opts.ValueSizeDistribution == "" || opts.ValueSizeDistribution == valueSizeFixed) && opts.ValuePoolSize <= 1 && opts.ValueCompressibility == 0 {
+		v.bytes = [][]byte{randBytes(opts.ValueSizeBytes)}
+		v.sampleSize = 1
+		return
+	}
+
+	sizes, err := newValueSizeGenerator(opts, rnd)
+	if err != nil {
+		return v, err
+	}
+	v.sampleSize = int(opts.ValuePoolSize)
+	if v.sampleSize <= 0 {
+		v.sampleSize = defaultValuePoolSize
+	}
+	v.bytes = make([][]byte, v.sampleSize)
+	for i := range v.bytes {
+		v.bytes[i] = randValue(rnd, sizes.next(), opts.ValueCompressibility)
+	}
 	return
 }
 
+// averageSize returns the average value size in bytes.
+func (v values) averageSize() float64 {
+	var sum int
+	for _, b := range v.bytes {
+		sum += len(b)
+	}
+	return float64(sum) / float64(len(v.bytes))
+}
+
+// requestLimit stops generating requests after 'RequestNumber' requests,
+// 'DurationSeconds', or whichever comes first, or when the run is canceled.
+type requestLimit struct {
+	n int64
+	// deadline is zero for no deadline.
+	deadline time.Time
+	ctx      context.Context
+}
+
+// newRequestLimit starts the duration from now.
+func newRequestLimit(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl) requestLimit {
+	l := requestLimit{n: gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, ctx: ctx}
+	if gcfg.ConfigClientMachineBenchmarkOptions.DurationSeconds > 0 {
+		l.deadline = time.Now().Add(time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.DurationSeconds) * time.Second)
+	}
+	return l
+}
+
+// more returns true if the i-th request (from 0) is within the limit.
+func (l requestLimit) more(i int64) bool {
+	if (l.n > 0 && i >= l.n) || l.ctx.Err() != nil {
+		return false
+	}
+	return l.deadline.IsZero() || time.Now().Before(l.deadline)
+}
+
+// requestPacer paces the requests by 'RateLimitRequestsPerSecond',
+// or by the rate of 'LoadProfile' at the time of each request.
+// In open-loop mode, the requests are scheduled at fixed intervals
+// regardless of the responses, and carry the scheduled send time,
+// so that the latency includes the time queued behind slow requests
+// (coordinated omission).
+type requestPacer struct {
+	ctx     context.Context
+	limiter *rate.Limiter
+
+	// start and rps schedule the requests in open-loop mode
+	start time.Time
+	rps   float64
+
+	// profile retunes the rate over time, and next is the
+	// scheduled send time of the next request in open-loop mode
+	profile loadProfile
+	next    time.Time
+}
+
+func newRequestPacer(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl) *requestPacer {
+	p := &requestPacer{
+		ctx:     ctx,
+		start:   time.Now(),
+		profile: loadProfile(gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile),
+	}
+	p.next = p.start
+	rps := float64(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond)
+	if len(p.profile) > 0 {
+		rps = p.profile.pacedRate(0)
+	}
+	switch {
+	case rps <= 0:
+	case gcfg.ConfigClientMachineBenchmarkOptions.OpenLoop:
+		p.rps = rps
+	default:
+		p.limiter = rate.NewLimiter(rate.Limit(rps), limiterBurst(rps))
+	}
+	return p
+}
+
+// limiterBurst allows one second of requests at once.
+func limiterBurst(rps float64) int {
+	if rps < 1 {
+		return 1
+	}
+	return int(rps)
+}
+
+// wait blocks until the i-th request (from 0) is due, or the run is
+// canceled. It returns the scheduled send time in open-loop mode,
+// or zero time otherwise. With a load profile, it must be called
+// in the order of the requests.
+func (p *requestPacer) wait(i int64) time.Time {
+	if p.limiter != nil {
+		if len(p.profile) > 0 {
+			p.retune(time.Since(p.start))
+		}
+		p.limiter.Wait(p.ctx)
+		return time.Time{}
+	}
+	if p.rps == 0 {
+		return time.Time{}
+	}
+	var intended time.Time
+	if len(p.profile) > 0 {
+		// the interval to the next request is of the rate at this one
+		intended = p.next
+		p.next = intended.Add(time.Duration(float64(time.Second) / p.profile.pacedRate(intended.Sub(p.start))))
+	} else {
+		intended = p.start.Add(time.Duration(float64(i) / p.rps * float64(time.Second)))
+	}
+	if d := time.Until(intended); d > 0 {
+		t := time.NewTimer(d)
+		select {
+		case <-t.C:
+		case <-p.ctx.Done():
+			t.Stop()
+		}
+	}
+	return intended
+}
+
+// retune sets the limiter to the profile rate at 'elapsed'.
+func (p *requestPacer) retune(elapsed time.Duration) {
+	rps := p.profile.pacedRate(elapsed)
+	if p.limiter.Limit() == rate.Limit(rps) {
+		return
+	}
+	p.limiter.SetLimit(rate.Limit(rps))
+	p.limiter.SetBurst(limiterBurst(rps))
+}
+
 // Stress stresses the database.
-func (cfg *Config) Stress(databaseID string) error {
+func (cfg *Config) Stress(databaseID string) (rerr error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q does not exist", databaseID)
 	}
 
-	vals, err := newValues(gcfg)
+	// SIGINT stops the requests, still saving the results so far,
+	// and the second SIGINT exits immediately
+	ctx, cancel := context.WithCancel(cfg.runContext())
+	defer cancel()
+	if cfg.part == nil {
+		// the load generator runs in the agent, which handles its own signals
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, os.Interrupt)
+		go func() {
+			select {
+			case <-sigc:
+				cfg.lg.Sugar().Warnf("interrupted, stopping requests to save partial results [database: %q]", gcfg.DatabaseID)
+				signal.Stop(sigc)
+				cancel()
+			case <-ctx.Done():
+				signal.Stop(sigc)
+			}
+		}()
+	}
+
+	if len(gcfg.LoadGeneratorEndpoints) > 0 && cfg.part == nil {
+		return cfg.stressDistributed(ctx, databaseID, gcfg)
+	}
+
+	drv, err := getDriver(gcfg.DatabaseID)
 	if err != nil {
 		return err
 	}
 
+	// record the seed, so that the run can be reproduced
+	if gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed == 0 {
+		gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed = time.Now().UnixNano()
+	}
+	rnd := mrand.New(mrand.NewSource(gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed))
+	cfg.lg.Sugar().Infof("random seed %d [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed, gcfg.DatabaseID)
+
+	vals, err := newValues(gcfg, rnd)
+	if err != nil {
+		return err
+	}
+	cfg.lg.Sugar().Infof("generated %d values of %.1f bytes on average [database: %q]", vals.sampleSize, vals.averageSize(), gcfg.DatabaseID)
+
+	if gcfg.ConfigClientMachineBenchmarkOptions.RecordTrace {
+		fpath := TracePath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
+		if cfg.trace, err = newTraceRecorder(fpath); err != nil {
+			return err
+		}
+		defer func() {
+			n, err := cfg.trace.close()
+			if err != nil && rerr == nil {
+				rerr = err
+			}
+			cfg.lg.Sugar().Infof("recorded trace of %d requests to %q", n, fpath)
+		}()
+	}
+
+	if gcfg.ConfigClientMachineBenchmarkOptions.SLOSearch != nil {
+		return cfg.searchSLO(ctx, drv, gcfg, vals, rnd)
+	}
+
 	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 	case "write":
 		cfg.lg.Info("write generateReport is started...")
 
+		keys, err := cfg.prepareWrites(drv, gcfg, vals, rnd)
+		if err != nil {
+			return err
+		}
+		overwrite := gcfg.ConfigClientMachineBenchmarkOptions.SameKey || keys != nil
+		reqCompleted := int64(0)
+		if gcfg.ConfigClientMachineBenchmarkOptions.VerifyWrites {
+			cfg.written = newWriteRecorder()
+		}
+
 		// fixed number of client numbers
 		if len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
-			h, done := newWriteHandlers(cfg.lg, gcfg)
-			reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
-			cfg.generateReport(gcfg, h, done, reqGen)
+			h, done := newClientHandlers(cfg.lg, drv, gcfg, overwrite, true)
+			reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+				generateWrites(ctx, gcfg, cfg.requestOffset(), vals, keys, inflightReqs)
+			}
+			st := cfg.generateReport(ctx, gcfg, h, done, reqGen)
+			reqCompleted = completedRequests(st)
 
 		} else {
 			// variable client numbers
 			rs := assignRequest(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+			ds := assignDuration(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers, gcfg.ConfigClientMachineBenchmarkOptions.DurationSeconds)
 
-			var stats []report.Stats
-			reqCompleted := int64(0)
-			for i := 0; i < len(rs); i++ {
+			var stats []latencyStats
+			var ext extraStats
+			for i := 0; i < len(rs) && ctx.Err() == nil; i++ {
 				copied := gcfg
+				// copy the options not to overwrite 'gcfg'
+				opts := *gcfg.ConfigClientMachineBenchmarkOptions
+				copied.ConfigClientMachineBenchmarkOptions = &opts
 				copied.ConfigClientMachineBenchmarkOptions.ConnectionNumber = gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
 				copied.ConfigClientMachineBenchmarkOptions.ClientNumber = gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
 				copied.ConfigClientMachineBenchmarkOptions.RequestNumber = rs[i]
+				copied.ConfigClientMachineBenchmarkOptions.DurationSeconds = ds[i]
 				ncfg := *cfg
 				ncfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = copied
 
@@ -88,12 +303,18 @@ func (cfg *Config) Stress(databaseID string) error {
 					}
 				}()
 
-				h, done := newWriteHandlers(cfg.lg, copied)
-				reqGen := func(inflightReqs chan<- request) { generateWrites(copied, reqCompleted, vals, inflightReqs) }
-				b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+				h, done := newClientHandlers(cfg.lg, drv, copied, overwrite, true)
+				reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+					generateWrites(ctx, copied, cfg.requestOffset()+reqCompleted, vals, keys, inflightReqs)
+				}
+				b := newBenchmark(ctx, copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, newLatencyPhases(copied.ConfigClientMachineBenchmarkOptions), requestTimeout(copied), newRetryPolicy(copied.ConfigClientMachineBenchmarkOptions), h, done, reqGen)
+				b.written = cfg.written
+				b.trace = cfg.trace
 
 				// wait until rs[i] requests are finished
 				// do not end reports yet
+				cfg.waitStart(ctx)
+				cfg.liveMetrics.begin(b, copied)
 				b.startRequests()
 				b.waitRequestsEnd()
 
@@ -102,411 +323,407 @@ func (cfg *Config) Stress(databaseID string) error {
 				b.finishReports()
 				cfg.lg.Sugar().Infof("finished reports... took %v", time.Since(now))
 
-				reqCompleted += rs[i]
+				// requests may end early on the deadline
+				reqCompleted += completedRequests(b.stats)
 				stats = append(stats, b.stats)
+				ext.merge(b)
 			}
 			cfg.lg.Info("combining all reports")
 
-			combined := report.Stats{ErrorDist: make(map[string]int)}
-			combinedClientNumber := make([]int64, 0, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+			combined := newLatencyStats()
+			clientNumbers := make(map[int64]int64)
 			for i, st := range stats {
-				combined.AvgTotal += st.AvgTotal
-				combined.Total += st.Total
-				combined.Lats = append(combined.Lats, st.Lats...)
-				combined.TimeSeries = append(combined.TimeSeries, st.TimeSeries...)
-				//
-				// Need to handle duplicate unix second timestamps when two ranges are merged.
-				// This can happen when the following run happens within the same unix timesecond,
-				// since finishing up the previous report and restarting the next range of requests
-				// with different number of clients takes only 100+/- ms.
-				//
-				// For instance, we have the following raw data:
-				//
-				//   unix-second, client-number, throughput
-				//   1486389257,       700,         30335  === ending of previous combined.TimeSeries
-				//   1486389258,      "700",        23188  === ending of previous combined.TimeSeries
-				//   1486389258,       1000,         5739  === beginning of current st.TimeSeries
-				//
-				// So now we have two duplicate unix time seconds.
-				// This will be handled in aggregating by keys.
-				//
-				clientN := gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
-				clientNs := make([]int64, len(st.TimeSeries))
-				for i := range st.TimeSeries {
-					clientNs[i] = clientN
-				}
-				combinedClientNumber = append(combinedClientNumber, clientNs...)
-
-				for k, v := range st.ErrorDist {
-					if _, ok := combined.ErrorDist[k]; !ok {
-						combined.ErrorDist[k] = v
-					} else {
-						combined.ErrorDist[k] += v
-					}
+				combined.merge(st)
+				// the next range may start within the last unix second of
+				// the previous one, since finishing up the previous report and
+				// restarting with different number of clients takes only 100+/- ms,
+				// then the second is merged with the client number of the next range
+				for _, dp := range st.TimeSeries {
+					clientNumbers[dp.Timestamp] = gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
 				}
 			}
-			if len(combined.TimeSeries) != len(combinedClientNumber) {
-				return fmt.Errorf("len(combined.TimeSeries) %d != len(combinedClientNumber) %d", len(combined.TimeSeries), len(combinedClientNumber))
-			}
-
-			combined.Average = combined.AvgTotal / float64(len(combined.Lats))
-			combined.RPS = float64(len(combined.Lats)) / combined.Total.Seconds()
-			cfg.lg.Sugar().Infof("got total %d data points and total %f seconds (RPS %f)", len(combined.Lats), combined.Total.Seconds(), combined.RPS)
-
-			for i := range combined.Lats {
-				dev := combined.Lats[i] - combined.Average
-				combined.Stddev += dev * dev
-			}
-			combined.Stddev = math.Sqrt(combined.Stddev / float64(len(combined.Lats)))
-
-			sort.Float64s(combined.Lats)
-			if len(combined.Lats) > 0 {
-				combined.Fastest = combined.Lats[0]
-				combined.Slowest = combined.Lats[len(combined.Lats)-1]
+			combined.update()
+			combinedClientNumber := make([]int64, len(combined.TimeSeries))
+			for i, dp := range combined.TimeSeries {
+				n, ok := clientNumbers[dp.Timestamp]
+				if !ok && i > 0 {
+					// the seconds between ranges
+					n = combinedClientNumber[i-1]
+				}
+				combinedClientNumber[i] = n
 			}
+			cfg.lg.Sugar().Infof("got total %d data points and total %f seconds (RPS %f)", combined.count(), combined.Total.Seconds(), combined.RPS)
 
 			cfg.lg.Info("combined all reports")
+			ext.update()
+			ext.interrupted = ctx.Err() != nil
 			printStats(combined)
-			cfg.saveAllStats(gcfg, combined, combinedClientNumber)
+			printExtraStats(ext)
+			cfg.saveAllStats(gcfg, combined, combinedClientNumber, ext)
 		}
 
 		cfg.lg.Info("write generateReport is finished...")
+		if cfg.part != nil {
+			// the control machine checks the keys written by all load generators
+			break
+		}
+
+		if cfg.written != nil {
+			return cfg.verifyWrites(drv, gcfg, cfg.written, rnd)
+		}
 
 		cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
-		var totalKeysFunc func(*zap.Logger, []string) map[string]int64
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			totalKeysFunc = getTotalKeysEtcdv3
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			totalKeysFunc = getTotalKeysZk
-		case "consul__v1_0_2", "cetcd__beta":
-			totalKeysFunc = getTotalKeysConsul
-		default:
-			cfg.lg.Fatal("unknown database ID", zap.String("database", gcfg.DatabaseID))
+		expectedTotal := reqCompleted
+		if keys != nil {
+			expectedTotal = gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize
 		}
-		for k, v := range totalKeysFunc(cfg.lg, gcfg.DatabaseEndpoints) {
+		for k, v := range drv.TotalKeys(cfg.lg, gcfg) {
 			cfg.lg.Sugar().Infof("expected write total results [expected_total: %d | database: %q | endpoint: %q | number_of_keys: %d]",
-				gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.DatabaseID, k, v)
+				expectedTotal, gcfg.DatabaseID, k, v)
 		}
 
 	case "read":
-		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
-
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-					totalConns:   1,
-					totalClients: 1,
-				})
-				_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
-				if err != nil {
-					continue
-				}
-				cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				cfg.lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
-
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
-				if err != nil {
-					continue
-				}
-				for j := range conns {
-					conns[j].Close()
-				}
-				cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				cfg.lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
-
-		case "consul__v1_0_2", "cetcd__beta":
-			cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				writer := newPutConsul(clients[0])
-				err = writer(context.Background(), &request{consulOp: consulOp{key: key, value: vals.bytes[0]}})
-				if err != nil {
-					continue
-				}
-				cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				cfg.lg.Sugar().Fatalf("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
-
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+		key, keys, err := cfg.prepareReads(drv, gcfg, vals, rnd)
+		if err != nil {
+			return err
 		}
 
-		h, done := newReadHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
-		cfg.generateReport(gcfg, h, done, reqGen)
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, false, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+			generateReads(ctx, gcfg, key, keys, inflightReqs)
+		}
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
 		cfg.lg.Info("read generateReport is finished...")
 
 	case "read-oneshot":
-		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
-		cfg.lg.Sugar().Infof("writing key for read-oneshot [key: %q | database: %q]", key, gcfg.DatabaseID)
-		var err error
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-				totalConns:   1,
-				totalClients: 1,
-			})
-			_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
-			clients[0].Close()
-
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, 1)
-			_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
-			conns[0].Close()
-
-		case "consul__v1_0_2", "cetcd__beta":
-			clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
-			writer := newPutConsul(clients[0])
-			err = writer(context.Background(), &request{consulOp: consulOp{key: key, value: vals.bytes[0]}})
+		key, keys, err := cfg.prepareReads(drv, gcfg, vals, rnd)
+		if err != nil {
+			return err
+		}
 
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+		h := newReadOneshotHandlers(drv, gcfg)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+			generateReads(ctx, gcfg, key, keys, inflightReqs)
 		}
+		cfg.generateReport(ctx, gcfg, h, nil, reqGen)
+		cfg.lg.Info("read-oneshot generateReport is finished...")
+
+	case "mixed":
+		cfg.lg.Sugar().Infof("preloading %d keys for mixed [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, gcfg.DatabaseID)
+		if err = cfg.preloadKeys(drv, gcfg, "", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, vals); err != nil {
+			return err
+		}
+
+		keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionSequential, rnd)
 		if err != nil {
-			cfg.lg.Sugar().Fatalf("write error on read-oneshot (%v)", err)
-			os.Exit(1)
+			return err
 		}
+		gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution = keyDistributionName(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionSequential)
 
-		h := newReadOneshotHandlers(cfg.lg, gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
-		cfg.generateReport(gcfg, h, nil, reqGen)
-		cfg.lg.Info("read-oneshot generateReport is finished...")
-	}
+		// writes update the preloaded keys, or create new ones with "latest"
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, true, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+			generateMixed(ctx, gcfg, vals, keys, rnd, inflightReqs)
+		}
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		cfg.lg.Info("mixed generateReport is finished...")
 
-	return nil
-}
+	case "delete":
+		cfg.lg.Sugar().Infof("preloading %d keys for delete [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.DatabaseID)
+		if err = cfg.preloadKeys(drv, gcfg, "", gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, vals); err != nil {
+			return err
+		}
+		for k, v := range drv.TotalKeys(cfg.lg, gcfg) {
+			cfg.lg.Sugar().Infof("total keys before delete [database: %q | endpoint: %q | number_of_keys: %d]", gcfg.DatabaseID, k, v)
+		}
 
-func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
-	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
-	switch gcfg.DatabaseID {
-	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
-			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
-		})
-		for i := range clients {
-			rhs[i] = newGetEtcd3(clients[i].KV)
-		}
-		done = func() {
-			for i := range clients {
-				clients[i].Close()
-			}
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, false, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) { generateDeletes(ctx, gcfg, inflightReqs) }
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		cfg.lg.Info("delete generateReport is finished...")
+
+		cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
+		for k, v := range drv.TotalKeys(cfg.lg, gcfg) {
+			cfg.lg.Sugar().Infof("expected delete total results [expected_total: 0 | database: %q | endpoint: %q | number_of_keys: %d]", gcfg.DatabaseID, k, v)
 		}
 
-	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			rhs[i] = newGetZK(conns[i])
+	case "range":
+		prefix := gcfg.ConfigClientMachineBenchmarkOptions.RangePrefix
+		cfg.lg.Sugar().Infof("preloading %d keys for range [prefix: %q | database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, prefix, gcfg.DatabaseID)
+		if err = cfg.preloadKeys(drv, gcfg, prefix, gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, vals); err != nil {
+			return err
 		}
-		done = func() {
-			for i := range conns {
-				conns[i].Close()
-			}
+
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, false, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) { generateRanges(ctx, gcfg, inflightReqs) }
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		cfg.lg.Info("range generateReport is finished...")
+
+	case "txn":
+		cfg.lg.Sugar().Infof("preloading %d keys for txn [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, gcfg.DatabaseID)
+		if err = cfg.preloadKeys(drv, gcfg, "", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, vals); err != nil {
+			return err
 		}
 
-	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			rhs[i] = newGetConsul(conns[i])
+		keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionUniform, rnd)
+		if err != nil {
+			return err
 		}
+		gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution = keyDistributionName(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionUniform)
 
-	default:
-		panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
-	}
-	return rhs, done
-}
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, true, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+			generateTxns(ctx, gcfg, vals, keys, inflightReqs)
+		}
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		cfg.lg.Info("txn generateReport is finished...")
 
-func newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
-	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
-	switch gcfg.DatabaseID {
-	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		etcdClients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
-			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
-		})
-		for i := range etcdClients {
-			rhs[i] = newPutEtcd3(etcdClients[i])
-		}
-
-		done = func() {
-			// this "done" function may decrease throughput at the end
-			for i := range etcdClients {
-				go func(idx int) {
-					etcdClients[idx].Close()
-				}(i)
-			}
+	case "register":
+		keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionUniform, rnd)
+		if err != nil {
+			return err
 		}
+		gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution = keyDistributionName(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionUniform)
 
-	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
-			key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
-			valueBts := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
-			lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, valueBts, zkCreateFlags, zkCreateACL)
-				if err != nil {
-					continue
-				}
-				for j := range conns {
-					conns[j].Close()
-				}
-				lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
+		cfg.history = &historyRecorder{}
+		if err = cfg.initRegisters(drv, gcfg, cfg.history); err != nil {
+			return err
 		}
 
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
-				rhs[i] = newPutOverwriteZK(conns[i])
-			} else {
-				rhs[i] = newPutCreateZK(conns[i])
-			}
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, true, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+			generateRegisters(ctx, gcfg, keys, rnd, inflightReqs)
+		}
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		cfg.lg.Info("register generateReport is finished...")
+
+	case "replay":
+		fpath := gcfg.ConfigClientMachineBenchmarkOptions.ReplayTracePath
+		n, err := countTrace(fpath)
+		if err != nil {
+			return err
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber > n || (gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber == 0 && gcfg.ConfigClientMachineBenchmarkOptions.DurationSeconds == 0) {
+			gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber = n
 		}
-		done = func() {
-			for i := range conns {
-				go func(idx int) {
-					conns[idx].Close()
-				}(i)
+		if gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize > 0 {
+			prefix := gcfg.ConfigClientMachineBenchmarkOptions.RangePrefix
+			cfg.lg.Sugar().Infof("preloading %d keys for replay [prefix: %q | database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, prefix, gcfg.DatabaseID)
+			if err = cfg.preloadKeys(drv, gcfg, prefix, gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, vals); err != nil {
+				return err
 			}
 		}
 
-	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			rhs[i] = newPutConsul(conns[i])
+		f, err := os.Open(fpath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		tr, err := newTraceReader(f)
+		if err != nil {
+			return err
 		}
+		cfg.lg.Sugar().Infof("replaying %d requests of %q [speedup: %g | database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, fpath, gcfg.ConfigClientMachineBenchmarkOptions.ReplaySpeedup, gcfg.DatabaseID)
 
-	default:
-		lg.Sugar().Fatalf("%q is unknown database ID", gcfg.DatabaseID)
-	}
+		// the puts may update the existing keys
+		h, done := newClientHandlers(cfg.lg, drv, gcfg, true, false)
+		reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+			generateReplay(ctx, cfg.lg, gcfg, tr, rnd, inflightReqs)
+		}
+		cfg.generateReport(ctx, gcfg, h, done, reqGen)
+		cfg.lg.Info("replay generateReport is finished...")
 
-	for k := range rhs {
-		if rhs[k] == nil {
-			lg.Sugar().Fatalf("%d-th write handler is nil (out of %d)", k, len(rhs))
+	case "watch":
+		if err = cfg.stressWatch(ctx, drv, gcfg); err != nil {
+			return err
 		}
-	}
-	return
-}
+		cfg.lg.Info("watch generateReport is finished...")
 
-func newReadOneshotHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
-	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
-	switch gcfg.DatabaseID {
-	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-					totalConns:   1,
-					totalClients: 1,
-				})
-				defer conns[0].Close()
-				return newGetEtcd3(conns[0])(ctx, req)
-			}
+	case "lease":
+		if err = cfg.stressLease(ctx, drv, gcfg, vals); err != nil {
+			return err
 		}
+		cfg.lg.Info("lease generateReport is finished...")
 
-	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				defer conns[0].Close()
-				return newGetZK(conns[0])(ctx, req)
-			}
+		cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
+		for k, v := range drv.TotalKeys(cfg.lg, gcfg) {
+			cfg.lg.Sugar().Infof("expected lease total results [expected_total: 0 | database: %q | endpoint: %q | number_of_keys: %d]", gcfg.DatabaseID, k, v)
 		}
+	}
 
-	case "consul__v1_0_2", "cetcd__beta":
-		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
-				return newGetConsul(conns[0])(ctx, req)
-			}
+	return nil
+}
+
+// writeKey writes a single key before the benchmark starts,
+// retrying on failures.
+func (cfg *Config) writeKey(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, value []byte) (err error) {
+	cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+	for i := 0; i < 7; i++ {
+		var clients []Client
+		var closeConns func()
+		clients, closeConns, err = drv.Connect(gcfg, ClientConfig{TotalConns: 1, TotalClients: 1})
+		if err != nil {
+			continue
 		}
-	default:
-		lg.Sugar().Fatalf("%q is unknown database ID", gcfg.DatabaseID)
+		err = clients[0].Put(context.Background(), key, value)
+		closeConns()
+		if err != nil {
+			continue
+		}
+		cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+		return nil
 	}
-	return rhs
+	return fmt.Errorf("write error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err)
 }
 
-func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
-	defer close(inflightReqs)
+// prepareWrites writes the keys to overwrite. It returns nil generator
+// to write the same key, or to create sequential keys. Otherwise, it
+// writes 'KeySpaceSize' keys and returns the generator to pick keys to overwrite.
+func (cfg *Config) prepareWrites(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, rnd *mrand.Rand) (keyIndexGenerator, error) {
+	switch {
+	case gcfg.ConfigClientMachineBenchmarkOptions.SameKey:
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+		return nil, cfg.writeKey(drv, gcfg, key, vals.bytes[0])
+
+	case insertsKeys(gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution):
+		// "latest" of writes only is always the next new key
+		gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution = keyDistributionSequential
+		return nil, nil
+	}
 
-	var rateLimiter *rate.Limiter
-	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
-		rateLimiter = rate.NewLimiter(
-			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-		)
+	cfg.lg.Sugar().Infof("preloading %d keys for write [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, gcfg.DatabaseID)
+	if err := cfg.preloadKeys(drv, gcfg, "", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, vals); err != nil {
+		return nil, err
 	}
+	return newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, "", rnd)
+}
 
-	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
-		if rateLimiter != nil {
-			rateLimiter.Wait(context.TODO())
-		}
+// prepareReads writes the keys to read. It writes the same key and returns
+// nil generator, if no key distribution is configured. Otherwise, it writes
+// 'KeySpaceSize' keys and returns the generator to pick keys to read.
+func (cfg *Config) prepareReads(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, rnd *mrand.Rand) (string, keyIndexGenerator, error) {
+	if gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution == "" {
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+		return key, nil, cfg.writeKey(drv, gcfg, key, vals.bytes[0])
+	}
 
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			opts := []clientv3.OpOption{clientv3.WithRange("")}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				opts = append(opts, clientv3.WithSerializable())
-			}
-			inflightReqs <- request{etcdv3Op: clientv3.OpGet(key, opts...)}
+	cfg.lg.Sugar().Infof("preloading %d keys for %s [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, gcfg.ConfigClientMachineBenchmarkOptions.Type, gcfg.DatabaseID)
+	if err := cfg.preloadKeys(drv, gcfg, "", gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize, vals); err != nil {
+		return "", nil, err
+	}
+	keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, "", rnd)
+	return "", keys, err
+}
 
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			op := zkOp{key: key}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				op.staleRead = true
+// preloadKeys writes 'n' sequential keys with the prefix before the benchmark
+// starts, so that reads and overwrites find existing keys.
+func (cfg *Config) preloadKeys(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, prefix string, n int64, vals values) error {
+	if !cfg.preloadsKeys() {
+		return nil
+	}
+	clients, closeConns, err := drv.Connect(gcfg, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+	})
+	if err != nil {
+		return err
+	}
+	defer closeConns()
+
+	idxc := make(chan int64, len(clients))
+	errc := make(chan error, 1)
+	var wg sync.WaitGroup
+	wg.Add(len(clients))
+	for i := range clients {
+		go func(c Client) {
+			defer wg.Done()
+			for idx := range idxc {
+				key := prefix + sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, idx)
+				if err := c.Put(context.Background(), key, vals.bytes[idx%int64(vals.sampleSize)]); err != nil {
+					select {
+					case errc <- fmt.Errorf("preload error [request: PUT | key: %q | database: %q] (%v)", key, gcfg.DatabaseID, err):
+					default:
+					}
+				}
 			}
-			inflightReqs <- request{zkOp: op}
+		}(clients[i])
+	}
+	for i := int64(0); i < n; i++ {
+		idxc <- i
+	}
+	close(idxc)
+	wg.Wait()
+
+	select {
+	case err = <-errc:
+		return err
+	default:
+	}
+	cfg.lg.Sugar().Infof("preloaded %d keys [prefix: %q | database: %q]", n, prefix, gcfg.DatabaseID)
+	return nil
+}
 
-		case "consul__v1_0_2", "cetcd__beta":
-			op := consulOp{key: key}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				op.staleRead = true
+// newClientHandlers returns one handler per client, closing the connections
+// on "done". With 'closeInBackground', "done" returns without waiting for
+// the connections to close, which may decrease throughput at the end.
+func newClientHandlers(lg *zap.Logger, drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, overwrite, closeInBackground bool) (rhs []ReqHandler, done func()) {
+	clients, closeConns, err := drv.Connect(gcfg, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		Overwrite:    overwrite,
+		MultiTxn:     gcfg.ConfigClientMachineBenchmarkOptions.TxnMode == txnModeMulti,
+	})
+	if err != nil {
+		lg.Sugar().Fatalf("failed to connect to %q (%v)", gcfg.DatabaseID, err)
+	}
+	rhs = make([]ReqHandler, len(clients))
+	for i := range clients {
+		rhs[i] = newReqHandler(drv, clients[i])
+	}
+	done = closeConns
+	if closeInBackground {
+		done = func() { go closeConns() }
+	}
+	return rhs, done
+}
+
+func newReadOneshotHandlers(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
+	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	for i := range rhs {
+		rhs[i] = func(ctx context.Context, req *Request) error {
+			clients, closeConns, err := drv.Connect(gcfg, ClientConfig{TotalConns: 1, TotalClients: 1})
+			if err != nil {
+				return categorizeError(drv, err)
 			}
-			inflightReqs <- request{consulOp: op}
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+			defer closeConns()
+			return newReqHandler(drv, clients[0])(ctx, req)
 		}
 	}
+	return rhs
 }
 
-func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- request) {
-	var rateLimiter *rate.Limiter
-	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
-		rateLimiter = rate.NewLimiter(
-			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-		)
+// generateReads reads the key, or the keys picked by 'keys' if not nil.
+func generateReads(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, keys keyIndexGenerator, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := newRequestPacer(ctx, gcfg)
+
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		k := key
+		if keys != nil {
+			k = sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, keys.next())
+		}
+
+		intended := pacer.wait(i)
+		inflightReqs <- Request{Op: OpGet, Key: k, StaleRead: gcfg.ConfigClientMachineBenchmarkOptions.StaleRead, Intended: intended}
 	}
+}
+
+// generateWrites creates sequential keys from 'startIdx',
+// or updates the keys picked by 'keys' if not nil.
+func generateWrites(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, keys keyIndexGenerator, inflightReqs chan<- Request) {
+	pacer := newRequestPacer(ctx, gcfg)
 
 	var wg sync.WaitGroup
 	defer func() {
@@ -514,31 +731,132 @@ func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx in
 		wg.Wait()
 	}()
 
-	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
-		k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, i+startIdx)
-		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		var k string
+		switch {
+		case gcfg.ConfigClientMachineBenchmarkOptions.SameKey:
 			k = sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+		case keys != nil:
+			k = sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, keys.next())
+		default:
+			k = sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, i+startIdx)
 		}
 
 		v := vals.bytes[i%int64(vals.sampleSize)]
-		vs := vals.strings[i%int64(vals.sampleSize)]
 
-		if rateLimiter != nil {
-			rateLimiter.Wait(context.TODO())
+		intended := pacer.wait(i)
+		inflightReqs <- Request{Op: OpPut, Key: k, Value: v, Intended: intended}
+	}
+}
+
+// generateDeletes deletes the preloaded sequential keys.
+func generateDeletes(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := newRequestPacer(ctx, gcfg)
+
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		intended := pacer.wait(i)
+		inflightReqs <- Request{Op: OpDelete, Key: sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, i), Intended: intended}
+	}
+}
+
+// generateRanges reads the preloaded keys with the prefix.
+func generateRanges(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := newRequestPacer(ctx, gcfg)
+
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		intended := pacer.wait(i)
+		inflightReqs <- Request{
+			Op:        OpRange,
+			Key:       gcfg.ConfigClientMachineBenchmarkOptions.RangePrefix,
+			Limit:     gcfg.ConfigClientMachineBenchmarkOptions.RangeLimit,
+			StaleRead: gcfg.ConfigClientMachineBenchmarkOptions.StaleRead,
+			Intended:  intended,
 		}
+	}
+}
 
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			inflightReqs <- request{etcdv3Op: clientv3.OpPut(k, vs)}
+const (
+	// txnModeCAS updates the key conditionally in one request.
+	txnModeCAS = "cas"
+	// txnModeMulti checks the version and updates the key
+	// in one multi-operation transaction.
+	txnModeMulti = "multi"
+)
 
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			inflightReqs <- request{zkOp: zkOp{key: "/" + k, value: v}}
+// generateTxns updates the keys picked by 'keys' out of the preloaded keys,
+// so that fewer keys mean more conflicts between clients.
+func generateTxns(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, keys keyIndexGenerator, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
 
-		case "consul__v1_0_2", "cetcd__beta":
-			inflightReqs <- request{consulOp: consulOp{key: k, value: v}}
+	pacer := newRequestPacer(ctx, gcfg)
 
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, keys.next())
+		v := vals.bytes[i%int64(vals.sampleSize)]
+
+		intended := pacer.wait(i)
+		inflightReqs <- Request{Op: OpTxn, Key: k, Value: v, Intended: intended}
+	}
+}
+
+// generateMixed interleaves reads and writes over the keys picked by 'keys',
+// with 'ReadPercent' of requests being reads. With "latest" distribution,
+// writes create new keys after the preloaded ones.
+func generateMixed(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, keys keyIndexGenerator, rnd *mrand.Rand, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := newRequestPacer(ctx, gcfg)
+
+	latest, _ := keys.(*latestGenerator)
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		var req Request
+		if rnd.Int63n(100) < gcfg.ConfigClientMachineBenchmarkOptions.ReadPercent {
+			k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, keys.next())
+			req = Request{Op: OpGet, Key: k, StaleRead: gcfg.ConfigClientMachineBenchmarkOptions.StaleRead}
+		} else {
+			var idx int64
+			if latest != nil {
+				idx = latest.insert()
+			} else {
+				idx = keys.next()
+			}
+			k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, idx)
+			req = Request{Op: OpPut, Key: k, Value: vals.bytes[i%int64(vals.sampleSize)]}
+		}
+
+		req.Intended = pacer.wait(i)
+		inflightReqs <- req
+	}
+}
+
+// generateRegisters reads and writes 'KeySpaceSize' registers. Each write
+// has a unique value (the request number from 1), so that the reads tell
+// which write they observed.
+func generateRegisters(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, keys keyIndexGenerator, rnd *mrand.Rand, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := newRequestPacer(ctx, gcfg)
+
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, keys.next())
+		var req Request
+		if rnd.Int63n(100) < gcfg.ConfigClientMachineBenchmarkOptions.ReadPercent {
+			req = Request{Op: OpGet, Key: k, StaleRead: gcfg.ConfigClientMachineBenchmarkOptions.StaleRead}
+		} else {
+			req = Request{Op: OpPut, Key: k, Value: []byte(fmt.Sprintf("%d", i+1))}
 		}
+
+		req.Intended = pacer.wait(i)
+		inflightReqs <- req
 	}
 }
@@ -15,16 +15,235 @@
 package dbtester
 
 import (
-	"go.etcd.io/etcd/client/v3"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
 	"golang.org/x/net/context"
 )
 
-type request struct {
-	etcdv3Op clientv3.Op
-	zkOp     zkOp
-	consulOp consulOp
+// Op is a backend-neutral operation type.
+type Op int
+
+const (
+	// OpPut writes a value to a key.
+	OpPut Op = iota
+	// OpGet reads a key.
+	OpGet
+	// OpDelete deletes a key.
+	OpDelete
+	// OpRange reads keys with a prefix.
+	OpRange
+	// OpTxn updates a key only if it is unchanged since read.
+	OpTxn
+	// OpLeaseGrant creates a lease, only reported by "lease" workload.
+	OpLeaseGrant
+	// OpLeaseKeepAlive refreshes a lease, only reported by "lease" workload.
+	OpLeaseKeepAlive
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpPut:
+		return "PUT"
+	case OpGet:
+		return "GET"
+	case OpDelete:
+		return "DELETE"
+	case OpRange:
+		return "RANGE"
+	case OpTxn:
+		return "TXN"
+	case OpLeaseGrant:
+		return "LEASE-GRANT"
+	case OpLeaseKeepAlive:
+		return "LEASE-KEEPALIVE"
+	}
+	return fmt.Sprintf("Op(%d)", int(op))
+}
+
+// Request is a single benchmark request, generated by the stress engine
+// and executed by a database Client.
+type Request struct {
+	Op Op
+	// Key is the key prefix for OpRange.
+	Key       string
+	Value     []byte
+	StaleRead bool
+	// Limit is the maximum number of keys returned by OpRange.
+	// 0 for no limit.
+	Limit int64
+	// Intended is the scheduled send time in open-loop mode,
+	// zero otherwise.
+	Intended time.Time
+
+	// RespBytes is the number of bytes returned,
+	// set by the request handler.
+	RespBytes int64
+	// Conflict is true when OpTxn did not update the key,
+	// set by the request handler.
+	Conflict bool
+	// ReadValue is the value returned by OpGet,
+	// set by the request handler.
+	ReadValue []byte
 }
 
 // ReqHandler wraps request handler.
-type ReqHandler func(ctx context.Context, req *request) error
+type ReqHandler func(ctx context.Context, req *Request) error
+
+// Client executes requests against a database.
+type Client interface {
+	Put(ctx context.Context, key string, value []byte) error
+	// Get returns the value of the key, nil if the key does not exist.
+	Get(ctx context.Context, key string, staleRead bool) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	// Range reads up to 'limit' keys with the prefix in key order,
+	// and returns the number of key and value bytes returned.
+	Range(ctx context.Context, prefix string, limit int64, staleRead bool) (int64, error)
+	// Watch returns after the watch on the key is registered, and sends
+	// the value of the key on each change notification. The channel is
+	// closed when the context is canceled or the watch fails.
+	Watch(ctx context.Context, key string) (<-chan []byte, error)
+	// WatchChildren returns after the watch on the children of the key
+	// (the keys with "key/" prefix) is registered, and sends the names of
+	// the children created since, on each change notification. The channel
+	// is closed when the context is canceled or the watch fails.
+	WatchChildren(ctx context.Context, key string) (<-chan []string, error)
+	// CompareAndSwap reads the version of the key, and updates the key
+	// only if the version is unchanged. It returns false on a conflict.
+	CompareAndSwap(ctx context.Context, key string, value []byte) (bool, error)
+	// Exists returns true if the key exists.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Grant creates a lease with the TTL, such as etcd lease,
+	// ZooKeeper session or Consul session.
+	Grant(ctx context.Context, ttl time.Duration) (Lease, error)
+}
+
+// Lease binds keys to its liveness, so that the keys are deleted
+// when the lease expires.
+type Lease interface {
+	// Put writes the key bound to the lease.
+	Put(ctx context.Context, key string, value []byte) error
+	// KeepAlive refreshes the lease once.
+	KeepAlive(ctx context.Context) error
+	// Expire stops refreshing the lease, so that the database
+	// expires it after its TTL, instead of revoking it.
+	Expire()
+	// Close releases the client resources, without revoking the lease.
+	Close() error
+}
+
+// ClientConfig configures the clients returned by Driver.Connect.
+type ClientConfig struct {
+	// TotalConns is the number of connections to open.
+	TotalConns int64
+	// TotalClients is the number of clients to return,
+	// sharing 'TotalConns' connections in round-robin order.
+	TotalClients int64
+	// Overwrite is true when puts update existing keys,
+	// instead of creating new ones.
+	Overwrite bool
+	// MultiTxn is true to run CompareAndSwap as the transaction of
+	// the version check and the update, where the database has both
+	// (ZooKeeper "Multi", Consul "Txn").
+	MultiTxn bool
+}
+
+// Driver connects the stress engine to a database.
+type Driver interface {
+	// Connect creates clients to the database endpoints. The connections
+	// shared by the clients are owned by the driver, and closed once by
+	// 'closeConns' after the clients are done.
+	Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) (clients []Client, closeConns func(), err error)
+	// TotalKeys returns the number of keys stored on each endpoint.
+	TotalKeys(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) map[string]int64
+}
+
+// errNotSupported is returned when the database does not support the operation.
+var errNotSupported = errors.New("not supported by the database")
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[dbtesterpb.DatabaseID]Driver)
+)
+
+// RegisterDriver makes a database driver available for the database ID.
+// It panics if the driver is nil or already registered for the ID.
+func RegisterDriver(id dbtesterpb.DatabaseID, d Driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if d == nil {
+		panic(fmt.Errorf("nil driver for %q", id))
+	}
+	if _, ok := drivers[id]; ok {
+		panic(fmt.Errorf("driver for %q is already registered", id))
+	}
+	drivers[id] = d
+}
+
+func getDriver(databaseID string) (Driver, error) {
+	id, ok := dbtesterpb.DatabaseID_value[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("%q is unknown database ID", databaseID)
+	}
+	driversMu.RLock()
+	d, ok := drivers[dbtesterpb.DatabaseID(id)]
+	driversMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no driver is registered for %q", databaseID)
+	}
+	return d, nil
+}
+
+// newReqHandler dispatches requests to the client,
+// and categorizes the errors by the driver.
+func newReqHandler(drv Driver, c Client) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		return categorizeError(drv, dispatchRequest(ctx, c, req))
+	}
+}
+
+func dispatchRequest(ctx context.Context, c Client, req *Request) error {
+	switch req.Op {
+	case OpPut:
+		return c.Put(ctx, req.Key, req.Value)
+	case OpGet:
+		v, err := c.Get(ctx, req.Key, req.StaleRead)
+		req.ReadValue = v
+		return err
+	case OpDelete:
+		return c.Delete(ctx, req.Key)
+	case OpRange:
+		n, err := c.Range(ctx, req.Key, req.Limit, req.StaleRead)
+		req.RespBytes = n
+		return err
+	case OpTxn:
+		swapped, err := c.CompareAndSwap(ctx, req.Key, req.Value)
+		req.Conflict = err == nil && !swapped
+		return err
+	}
+	return fmt.Errorf("unknown operation %v", req.Op)
+}
+
+// rangeKeys returns up to 'limit' sorted keys with the prefix,
+// for databases that list keys without server-side prefix and limit.
+// 0 'limit' returns all keys with the prefix.
+func rangeKeys(keys []string, prefix string, limit int64) []string {
+	rs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if strings.HasPrefix(k, prefix) {
+			rs = append(rs, k)
+		}
+	}
+	sort.Strings(rs)
+	if limit > 0 && int64(len(rs)) > limit {
+		rs = rs[:limit]
+	}
+	return rs
+}
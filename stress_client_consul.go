@@ -15,56 +15,348 @@
 package dbtester
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	consulapi "github.com/hashicorp/consul/api"
 	"go.uber.org/zap"
-	"go.ytsaurus.tech/yt/go/ypath"
-	"go.ytsaurus.tech/yt/go/yt/ytrpc"
 	"golang.org/x/net/context"
-
-	"go.ytsaurus.tech/yt/go/yt"
 )
 
-type consulOp struct {
-	key       string
-	value     []byte
-	staleRead bool
+func init() {
+	RegisterDriver(dbtesterpb.DatabaseID_consul__v1_0_2, consulDriver{})
+	RegisterDriver(dbtesterpb.DatabaseID_cetcd__beta, consulDriver{})
+}
+
+type consulDriver struct{}
+
+// Connect returns no-op 'closeConns', since Consul client is stateless HTTP.
+func (consulDriver) Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) ([]Client, func(), error) {
+	conns, err := createConnsConsul(gcfg.DatabaseEndpoints, ccfg.TotalConns)
+	if err != nil {
+		return nil, nil, err
+	}
+	cs := make([]Client, ccfg.TotalClients)
+	for i := range cs {
+		cs[i] = &consulClient{conn: conns[i%len(conns)].KV(), session: conns[i%len(conns)].Session(), multi: ccfg.MultiTxn}
+	}
+	return cs, func() {}, nil
+}
+
+func (consulDriver) TotalKeys(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) map[string]int64 {
+	return getTotalKeysConsul(lg, gcfg.DatabaseEndpoints)
 }
 
-func mustCreateConnsConsul(endpoints []string, total int64) []*yt.Client {
-	css := make([]*yt.Client, total)
+// ClassifyError classifies the HTTP status codes of Consul errors
+// (e.g. "Unexpected response code: 500 (No cluster leader)").
+func (consulDriver) ClassifyError(err error) string {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "No cluster leader"):
+		return ErrorNoLeader
+	case strings.Contains(msg, "response code: 429"):
+		return ErrorQuotaExceeded
+	case strings.Contains(msg, "response code: 503"), strings.Contains(msg, "response code: 502"):
+		return ErrorUnavailable
+	case strings.Contains(msg, "response code: 504"):
+		return ErrorTimeout
+	case strings.Contains(msg, "response code: 404"):
+		return ErrorNotFound
+	case strings.Contains(msg, "response code: 409"):
+		return ErrorConflict
+	}
+	return ""
+}
+
+func createConnConsul(endpoint string) (*consulapi.Client, error) {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = endpoint // x.x.x.x:8500
+	return consulapi.NewClient(dcfg)
+}
+
+func createConnsConsul(endpoints []string, total int64) ([]*consulapi.Client, error) {
+	css := make([]*consulapi.Client, total)
 	for i := range css {
-		c, err := ytrpc.NewClient(&yt.Config{
-			RPCProxy:              "46.243.144.15:9013",
-			DisableProxyDiscovery: true,
-			Token:                 "password",
-		})
+		// round-robin over the endpoints
+		endpoint := endpoints[i%len(endpoints)]
+		cli, err := createConnConsul(endpoint)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
-		css[i] = &c
+		css[i] = cli
 	}
-	return css
+	return css, nil
 }
 
-func newPutConsul(conn *yt.Client) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		op := req.consulOp
-		err := (*conn).SetNode(ctx, ypath.Path("//tmp/@"+op.key), op.value, nil)
+// consulQueryOptions returns the read options for the consistency mode.
+// Stale reads can be served by any server, while consistent reads
+// are verified by the leader with a quorum.
+func consulQueryOptions(ctx context.Context, staleRead bool) *consulapi.QueryOptions {
+	opt := &consulapi.QueryOptions{}
+	if staleRead {
+		opt.AllowStale = true
+		opt.RequireConsistent = false
+	} else {
+		opt.AllowStale = false
+		opt.RequireConsistent = true
+	}
+	return opt.WithContext(ctx)
+}
+
+// consulKeyPrefix is the directory of all benchmark keys, so that
+// they are counted apart from the other keys of the cluster.
+const consulKeyPrefix = "dbtester/"
+
+type consulClient struct {
+	conn    *consulapi.KV
+	session *consulapi.Session
+	// multi is true to check the index and set the key in "Txn".
+	multi bool
+}
+
+func (c *consulClient) Put(ctx context.Context, key string, value []byte) error {
+	wopt := &consulapi.WriteOptions{}
+	_, err := c.conn.Put(&consulapi.KVPair{Key: consulKeyPrefix + key, Value: value}, wopt.WithContext(ctx))
+	return err
+}
+
+func (c *consulClient) Get(ctx context.Context, key string, staleRead bool) ([]byte, error) {
+	pair, _, err := c.conn.Get(consulKeyPrefix+key, consulQueryOptions(ctx, staleRead))
+	if err != nil || pair == nil {
+		return nil, err
+	}
+	return pair.Value, nil
+}
+
+func (c *consulClient) Delete(ctx context.Context, key string) error {
+	wopt := &consulapi.WriteOptions{}
+	_, err := c.conn.Delete(consulKeyPrefix+key, wopt.WithContext(ctx))
+	return err
+}
+
+// Range lists all pairs with the prefix, or lists the keys
+// and gets the first 'limit' ones, since Consul does not support
+// limiting the number of listed pairs.
+func (c *consulClient) Range(ctx context.Context, prefix string, limit int64, staleRead bool) (int64, error) {
+	prefix = consulKeyPrefix + prefix
+	var n int64
+	if limit == 0 {
+		pairs, _, err := c.conn.List(prefix, consulQueryOptions(ctx, staleRead))
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range pairs {
+			n += int64(len(p.Key) - len(consulKeyPrefix) + len(p.Value))
+		}
+		return n, nil
+	}
+
+	keys, _, err := c.conn.Keys(prefix, "", consulQueryOptions(ctx, staleRead))
+	if err != nil {
+		return 0, err
+	}
+	for _, k := range rangeKeys(keys, prefix, limit) {
+		p, _, err := c.conn.Get(k, consulQueryOptions(ctx, staleRead))
+		if err != nil {
+			return n, err
+		}
+		n += int64(len(k) - len(consulKeyPrefix))
+		if p != nil {
+			n += int64(len(p.Value))
+		}
+	}
+	return n, nil
+}
+
+// Watch issues blocking queries on the key, waiting for
+// the index after the last response.
+func (c *consulClient) Watch(ctx context.Context, key string) (<-chan []byte, error) {
+	key = consulKeyPrefix + key
+	_, meta, err := c.conn.Get(key, consulQueryOptions(ctx, false))
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []byte, 1)
+	go func() {
+		defer close(ch)
+		idx := meta.LastIndex
+		for {
+			qopts := consulQueryOptions(ctx, false)
+			qopts.WaitIndex = idx
+			p, meta, err := c.conn.Get(key, qopts)
+			if err != nil {
+				return
+			}
+			if meta.LastIndex == idx || p == nil {
+				// wait timed out, or the key is deleted
+				idx = meta.LastIndex
+				continue
+			}
+			idx = meta.LastIndex
+			select {
+			case ch <- p.Value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// WatchChildren lists the keys with the prefix in blocking queries,
+// and sends the ones not listed before.
+func (c *consulClient) WatchChildren(ctx context.Context, key string) (<-chan []string, error) {
+	prefix := consulKeyPrefix + key + "/"
+	keys, meta, err := c.conn.Keys(prefix, "", consulQueryOptions(ctx, false))
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		seen[k] = struct{}{}
+	}
+
+	ch := make(chan []string, 1)
+	go func() {
+		defer close(ch)
+		idx := meta.LastIndex
+		for {
+			qopts := consulQueryOptions(ctx, false)
+			qopts.WaitIndex = idx
+			keys, meta, err := c.conn.Keys(prefix, "", qopts)
+			if err != nil {
+				return
+			}
+			if meta.LastIndex == idx {
+				// wait timed out
+				continue
+			}
+			idx = meta.LastIndex
+			var names []string
+			for _, k := range keys {
+				if _, ok := seen[k]; !ok {
+					seen[k] = struct{}{}
+					names = append(names, strings.TrimPrefix(k, prefix))
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+			select {
+			case ch <- names:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (c *consulClient) CompareAndSwap(ctx context.Context, key string, value []byte) (bool, error) {
+	key = consulKeyPrefix + key
+	p, _, err := c.conn.Get(key, consulQueryOptions(ctx, false))
+	if err != nil {
+		return false, err
+	}
+	// 0 index only creates the key if it does not exist
+	var idx uint64
+	if p != nil {
+		idx = p.ModifyIndex
+	}
+	// "check-index" fails on the missing key, which is created by "CAS"
+	if c.multi && p != nil {
+		// rolled back without error on the index mismatch
+		ops := consulapi.KVTxnOps{
+			&consulapi.KVTxnOp{Verb: consulapi.KVCheckIndex, Key: key, Index: idx},
+			&consulapi.KVTxnOp{Verb: consulapi.KVSet, Key: key, Value: value},
+		}
+		swapped, _, _, err := c.conn.Txn(ops, (&consulapi.QueryOptions{}).WithContext(ctx))
+		return swapped, err
+	}
+	wopt := &consulapi.WriteOptions{}
+	swapped, _, err := c.conn.CAS(&consulapi.KVPair{Key: key, Value: value, ModifyIndex: idx}, wopt.WithContext(ctx))
+	return swapped, err
+}
+
+func (c *consulClient) Exists(ctx context.Context, key string) (bool, error) {
+	p, _, err := c.conn.Get(consulKeyPrefix+key, consulQueryOptions(ctx, false))
+	return p != nil, err
+}
+
+// Grant creates a session that deletes the keys acquired by it
+// when invalidated.
+func (c *consulClient) Grant(ctx context.Context, ttl time.Duration) (Lease, error) {
+	wopt := &consulapi.WriteOptions{}
+	id, _, err := c.session.Create(&consulapi.SessionEntry{
+		TTL:      ttl.String(),
+		Behavior: consulapi.SessionBehaviorDelete,
+	}, wopt.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &consulLease{kv: c.conn, session: c.session, id: id}, nil
+}
+
+type consulLease struct {
+	kv      *consulapi.KV
+	session *consulapi.Session
+	id      string
+}
+
+func (l *consulLease) Put(ctx context.Context, key string, value []byte) error {
+	wopt := &consulapi.WriteOptions{}
+	ok, _, err := l.kv.Acquire(&consulapi.KVPair{Key: consulKeyPrefix + key, Value: value, Session: l.id}, wopt.WithContext(ctx))
+	if err != nil {
 		return err
 	}
+	if !ok {
+		return fmt.Errorf("failed to acquire %q with session %q", key, l.id)
+	}
+	return nil
 }
 
-func newGetConsul(conn *yt.Client) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		var value any
-		err := (*conn).GetNode(ctx, ypath.Path("//tmp/@"+req.consulOp.key), &value, nil)
+func (l *consulLease) KeepAlive(ctx context.Context) error {
+	wopt := &consulapi.WriteOptions{}
+	se, _, err := l.session.Renew(l.id, wopt.WithContext(ctx))
+	if err != nil {
 		return err
 	}
+	if se == nil {
+		return fmt.Errorf("session %q is invalidated", l.id)
+	}
+	return nil
 }
 
+// Expire is no-op, since the session is only renewed by KeepAlive.
+func (l *consulLease) Expire() {}
+
+func (l *consulLease) Close() error { return nil }
+
+// getTotalKeysConsul counts the benchmark keys under consulKeyPrefix
+// on each server.
 func getTotalKeysConsul(lg *zap.Logger, endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
-		rs[ep] = 0 // not supported in consul
+		cli, err := createConnConsul(ep)
+		if err != nil {
+			lg.Warn("failed to create consul client", zap.String("endpoint", ep), zap.Error(err))
+			rs[ep] = 0
+			continue
+		}
+		// stale read, so that each server answers from its own state
+		keys, _, err := cli.KV().Keys(consulKeyPrefix, "", consulQueryOptions(context.Background(), true))
+		if err != nil {
+			lg.Warn("failed to list keys", zap.String("endpoint", ep), zap.Error(err))
+			rs[ep] = 0
+			continue
+		}
+		rs[ep] = int64(len(keys))
 	}
+
+	lg.Info("getTotalKeysConsul", zap.String("response", fmt.Sprintf("%+v", rs)))
 	return rs
 }
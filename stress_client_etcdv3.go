@@ -17,28 +17,88 @@ package dbtester
 import (
 	"bufio"
 	"fmt"
+	"math"
 	"net/http"
-	"os"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
 
 	"go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func newPutEtcd3(conn clientv3.KV) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		_, err := conn.Do(ctx, req.etcdv3Op)
-		return err
+func init() {
+	for _, id := range []dbtesterpb.DatabaseID{
+		dbtesterpb.DatabaseID_etcd__other,
+		dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3,
+	} {
+		RegisterDriver(id, etcdv3Driver{})
+	}
+}
+
+type etcdv3Driver struct{}
+
+func (etcdv3Driver) Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) ([]Client, func(), error) {
+	conns, err := createConnsEtcdv3(gcfg.DatabaseEndpoints, ccfg.TotalConns)
+	if err != nil {
+		return nil, nil, err
+	}
+	cs := make([]Client, ccfg.TotalClients)
+	for i := range cs {
+		cs[i] = &etcdv3Client{conns[i%len(conns)]}
+	}
+	closeConns := func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}
+	return cs, closeConns, nil
+}
+
+func (etcdv3Driver) TotalKeys(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) map[string]int64 {
+	return getTotalKeysEtcdv3(lg, gcfg.DatabaseEndpoints)
+}
+
+// ClassifyError classifies the gRPC status codes of etcd errors
+// (e.g. "etcdserver: no leader" is "Unavailable").
+func (etcdv3Driver) ClassifyError(err error) string {
+	code := codes.Unknown
+	if ec, ok := err.(interface{ Code() codes.Code }); ok {
+		// etcd client errors (e.g. rpctypes.ErrNoLeader)
+		code = ec.Code()
+	} else if s, ok := status.FromError(err); ok {
+		code = s.Code()
 	}
+	switch {
+	case strings.Contains(err.Error(), "no leader"), strings.Contains(err.Error(), "leader changed"):
+		return ErrorNoLeader
+	case code == codes.DeadlineExceeded:
+		return ErrorTimeout
+	case code == codes.Unavailable:
+		return ErrorUnavailable
+	case code == codes.ResourceExhausted:
+		// database space exceeded, or too many requests
+		return ErrorQuotaExceeded
+	case code == codes.NotFound:
+		return ErrorNotFound
+	case code == codes.FailedPrecondition, code == codes.Aborted:
+		return ErrorConflict
+	}
+	return ""
 }
 
-// dialTotal counts the number of mustCreateConn calls so that endpoint
+// dialTotal counts the number of dialed connections so that endpoint
 // connections can be handed out in round-robin order
 var dialTotal int
 
-func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
+func createConnEtcdv3(endpoints []string) (*clientv3.Client, error) {
 	// For parity with consul:
 	// endpoint := endpoints[dialTotal%len(endpoints)]
 	// dialTotal++
@@ -50,40 +110,184 @@ func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
 	cfg := clientv3.Config{
 		Endpoints: endpoints,
 	}
+	return clientv3.New(cfg)
+}
 
-	client, err := clientv3.New(cfg)
+func createConnsEtcdv3(endpoints []string, total int64) ([]*clientv3.Client, error) {
+	conns := make([]*clientv3.Client, total)
+	for i := range conns {
+		conn, err := createConnEtcdv3(endpoints)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				conns[j].Close()
+			}
+			return nil, fmt.Errorf("dial error: %v", err)
+		}
+		conns[i] = conn
+	}
+	return conns, nil
+}
+
+type etcdv3Client struct {
+	*clientv3.Client
+}
+
+func (c *etcdv3Client) Put(ctx context.Context, key string, value []byte) error {
+	_, err := c.Do(ctx, clientv3.OpPut(key, string(value)))
+	return err
+}
+
+func (c *etcdv3Client) Get(ctx context.Context, key string, staleRead bool) ([]byte, error) {
+	opts := []clientv3.OpOption{clientv3.WithRange("")}
+	if staleRead {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	resp, err := c.Do(ctx, clientv3.OpGet(key, opts...))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "dial error: %v\n", err)
-		os.Exit(1)
+		return nil, err
+	}
+	if kvs := resp.Get().Kvs; len(kvs) > 0 {
+		return kvs[0].Value, nil
 	}
-	return client
+	return nil, nil
 }
 
-type etcdv3ClientCfg struct {
-	totalConns   int64
-	totalClients int64
+func (c *etcdv3Client) Delete(ctx context.Context, key string) error {
+	_, err := c.Do(ctx, clientv3.OpDelete(key))
+	return err
 }
 
-func mustCreateClientsEtcdv3(endpoints []string, cfg etcdv3ClientCfg) []*clientv3.Client {
-	conns := make([]*clientv3.Client, cfg.totalConns)
-	for i := range conns {
-		conns[i] = mustCreateConnEtcdv3(endpoints)
+func (c *etcdv3Client) Range(ctx context.Context, prefix string, limit int64, staleRead bool) (int64, error) {
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithLimit(limit)}
+	if staleRead {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	resp, err := c.Do(ctx, clientv3.OpGet(prefix, opts...))
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for _, kv := range resp.Get().Kvs {
+		n += int64(len(kv.Key) + len(kv.Value))
+	}
+	return n, nil
+}
+
+func (c *etcdv3Client) Watch(ctx context.Context, key string) (<-chan []byte, error) {
+	wch := c.Client.Watch(ctx, key, clientv3.WithCreatedNotify())
+	wr, ok := <-wch
+	if !ok {
+		return nil, fmt.Errorf("watch on %q is closed before created", key)
+	}
+	if err := wr.Err(); err != nil {
+		return nil, err
 	}
 
-	clients := make([]*clientv3.Client, cfg.totalClients)
-	for i := range clients {
-		clients[i] = conns[i%int(cfg.totalConns)]
+	ch := make(chan []byte, 1)
+	go func() {
+		defer close(ch)
+		for wr := range wch {
+			for _, ev := range wr.Events {
+				select {
+				case ch <- ev.Kv.Value:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (c *etcdv3Client) WatchChildren(ctx context.Context, key string) (<-chan []string, error) {
+	prefix := key + "/"
+	wch := c.Client.Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithFilterDelete(), clientv3.WithCreatedNotify())
+	wr, ok := <-wch
+	if !ok {
+		return nil, fmt.Errorf("watch on %q is closed before created", prefix)
 	}
-	return clients
+	if err := wr.Err(); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []string, 1)
+	go func() {
+		defer close(ch)
+		for wr := range wch {
+			var names []string
+			for _, ev := range wr.Events {
+				if ev.IsCreate() {
+					names = append(names, strings.TrimPrefix(string(ev.Kv.Key), prefix))
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+			select {
+			case ch <- names:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
 }
 
-func newGetEtcd3(conn clientv3.KV) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		_, err := conn.Do(ctx, req.etcdv3Op)
-		return err
+func (c *etcdv3Client) CompareAndSwap(ctx context.Context, key string, value []byte) (bool, error) {
+	resp, err := c.Do(ctx, clientv3.OpGet(key))
+	if err != nil {
+		return false, err
 	}
+	var rev int64
+	if kvs := resp.Get().Kvs; len(kvs) > 0 {
+		rev = kvs[0].ModRevision
+	}
+	tresp, err := c.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", rev)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return tresp.Succeeded, nil
+}
+
+func (c *etcdv3Client) Exists(ctx context.Context, key string) (bool, error) {
+	resp, err := c.Do(ctx, clientv3.OpGet(key, clientv3.WithCountOnly()))
+	if err != nil {
+		return false, err
+	}
+	return resp.Get().Count > 0, nil
+}
+
+func (c *etcdv3Client) Grant(ctx context.Context, ttl time.Duration) (Lease, error) {
+	resp, err := c.Client.Grant(ctx, int64(math.Ceil(ttl.Seconds())))
+	if err != nil {
+		return nil, err
+	}
+	return &etcdv3Lease{cli: c.Client, id: resp.ID}, nil
 }
 
+type etcdv3Lease struct {
+	cli *clientv3.Client
+	id  clientv3.LeaseID
+}
+
+func (l *etcdv3Lease) Put(ctx context.Context, key string, value []byte) error {
+	_, err := l.cli.Put(ctx, key, string(value), clientv3.WithLease(l.id))
+	return err
+}
+
+func (l *etcdv3Lease) KeepAlive(ctx context.Context) error {
+	_, err := l.cli.KeepAliveOnce(ctx, l.id)
+	return err
+}
+
+// Expire is no-op, since the lease is only refreshed by KeepAlive.
+func (l *etcdv3Lease) Expire() {}
+
+func (l *etcdv3Lease) Close() error { return nil }
+
 func getTotalKeysEtcdv3(lg *zap.Logger, endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
@@ -0,0 +1,51 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestGetDriver(t *testing.T) {
+	for _, id := range dbtesterpb.GetAllDatabaseIDs() {
+		if _, err := getDriver(id); err != nil {
+			t.Fatalf("%q: %v", id, err)
+		}
+	}
+	if _, err := getDriver("unknown"); err == nil {
+		t.Fatal("expected error for unknown database ID")
+	}
+}
+
+func Test_rangeKeys(t *testing.T) {
+	keys := []string{"range-2", "zookeeper", "range-0", "other", "range-1"}
+	tests := []struct {
+		limit    int64
+		expected []string
+	}{
+		{0, []string{"range-0", "range-1", "range-2"}},
+		{2, []string{"range-0", "range-1"}},
+		{5, []string{"range-0", "range-1", "range-2"}},
+	}
+	for i, tt := range tests {
+		rs := rangeKeys(keys, "range-", tt.limit)
+		if !reflect.DeepEqual(rs, tt.expected) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.expected, rs)
+		}
+	}
+}
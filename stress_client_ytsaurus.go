@@ -0,0 +1,298 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"go.ytsaurus.tech/yt/go/ypath"
+	"go.ytsaurus.tech/yt/go/yt"
+	"go.ytsaurus.tech/yt/go/yt/ytrpc"
+	"go.ytsaurus.tech/yt/go/yterrors"
+	"golang.org/x/net/context"
+)
+
+const (
+	// ytsaurusStorageAttribute stores each key as an attribute of the root node.
+	ytsaurusStorageAttribute = "attribute"
+	// ytsaurusStorageDocument stores each key as a document node under the root node.
+	ytsaurusStorageDocument = "document"
+)
+
+func init() {
+	RegisterDriver(dbtesterpb.DatabaseID_ytsaurus_cypress, &ytsaurusDriver{})
+}
+
+type ytsaurusDriver struct {
+	mu sync.Mutex
+	// roots is the set of root paths that have been created,
+	// so that one-shot clients do not create it on every request.
+	roots map[string]struct{}
+}
+
+func (d *ytsaurusDriver) Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) ([]Client, func(), error) {
+	flag := gcfg.Flag_Ytsaurus_Cypress
+	if flag == nil {
+		return nil, nil, fmt.Errorf("%q has no 'ytsaurus_cypress' flags", gcfg.DatabaseID)
+	}
+	conns, err := createConnsYTsaurus(flag, ccfg.TotalConns)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeConns := func() {
+		for i := range conns {
+			conns[i].Stop()
+		}
+	}
+	if err = d.createRoot(conns[0], flag.RootPath); err != nil {
+		closeConns()
+		return nil, nil, err
+	}
+
+	cs := make([]Client, ccfg.TotalClients)
+	for i := range cs {
+		cs[i] = &ytsaurusClient{
+			conn:     conns[i%len(conns)],
+			root:     ypath.Path(flag.RootPath),
+			document: flag.StorageMode == ytsaurusStorageDocument,
+		}
+	}
+	return cs, closeConns, nil
+}
+
+func (d *ytsaurusDriver) createRoot(conn yt.Client, root string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.roots[root]; ok {
+		return nil
+	}
+	_, err := conn.CreateNode(context.Background(), ypath.Path(root), yt.NodeMap, &yt.CreateNodeOptions{
+		Recursive:      true,
+		IgnoreExisting: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %q (%v)", root, err)
+	}
+	if d.roots == nil {
+		d.roots = make(map[string]struct{})
+	}
+	d.roots[root] = struct{}{}
+	return nil
+}
+
+func (d *ytsaurusDriver) TotalKeys(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) map[string]int64 {
+	return getTotalKeysYTsaurus(lg, gcfg.Flag_Ytsaurus_Cypress)
+}
+
+// ClassifyError classifies the YTsaurus error codes.
+func (d *ytsaurusDriver) ClassifyError(err error) string {
+	switch {
+	case yterrors.ContainsErrorCode(err, yterrors.CodeTimeout):
+		return ErrorTimeout
+	case yterrors.ContainsErrorCode(err, yterrors.CodeUnavailable), yterrors.ContainsErrorCode(err, yterrors.CodePeerBanned):
+		return ErrorUnavailable
+	case yterrors.ContainsErrorCode(err, yterrors.CodeTransportError):
+		return ErrorConnectionReset
+	case yterrors.ContainsErrorCode(err, yterrors.CodeConcurrentTransactionLockConflict), yterrors.ContainsErrorCode(err, yterrors.CodePrerequisiteCheckFailed):
+		return ErrorConflict
+	case yterrors.ContainsErrorCode(err, yterrors.CodeAccountLimitExceeded), yterrors.ContainsErrorCode(err, yterrors.CodeRequestQueueSizeLimitExceeded):
+		return ErrorQuotaExceeded
+	case yterrors.ContainsResolveError(err):
+		return ErrorNotFound
+	}
+	return ""
+}
+
+func createConnYTsaurus(flag *dbtesterpb.Flag_Ytsaurus_Cypress) (yt.Client, error) {
+	return ytrpc.NewClient(&yt.Config{
+		RPCProxy:              flag.RPCProxy,
+		DisableProxyDiscovery: true,
+		Token:                 flag.Token,
+	})
+}
+
+func createConnsYTsaurus(flag *dbtesterpb.Flag_Ytsaurus_Cypress, total int64) ([]yt.Client, error) {
+	css := make([]yt.Client, total)
+	for i := range css {
+		c, err := createConnYTsaurus(flag)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				css[j].Stop()
+			}
+			return nil, err
+		}
+		css[i] = c
+	}
+	return css, nil
+}
+
+// ytsaurusClient stores keys in Cypress under the root node.
+type ytsaurusClient struct {
+	conn     yt.Client
+	root     ypath.Path
+	document bool
+}
+
+// node returns the node that stores the key, which is
+// the root node for all keys in attribute storage mode.
+func (c *ytsaurusClient) node(key string) ypath.Path {
+	if c.document {
+		return c.root.Child(key)
+	}
+	return c.root
+}
+
+func (c *ytsaurusClient) path(key string) ypath.Path {
+	if c.document {
+		return c.root.Child(key)
+	}
+	return c.root.Attr(key)
+}
+
+// Put sets the node, which creates a document node or an attribute
+// if it does not exist yet.
+func (c *ytsaurusClient) Put(ctx context.Context, key string, value []byte) error {
+	return c.conn.SetNode(ctx, c.path(key), value, nil)
+}
+
+func ytsaurusReadOptions(staleRead bool) *yt.MasterReadOptions {
+	readFrom := yt.ReadFromLeader
+	if staleRead {
+		readFrom = yt.ReadFromFollower
+	}
+	return &yt.MasterReadOptions{ReadFrom: readFrom}
+}
+
+func (c *ytsaurusClient) Get(ctx context.Context, key string, staleRead bool) ([]byte, error) {
+	var value []byte
+	err := c.conn.GetNode(ctx, c.path(key), &value, &yt.GetNodeOptions{
+		MasterReadOptions: ytsaurusReadOptions(staleRead),
+	})
+	return value, err
+}
+
+func (c *ytsaurusClient) Delete(ctx context.Context, key string) error {
+	return c.conn.RemoveNode(ctx, c.path(key), nil)
+}
+
+// Range lists the keys under the root node and gets the ones with the prefix,
+// since Cypress does not support prefix reads.
+func (c *ytsaurusClient) Range(ctx context.Context, prefix string, limit int64, staleRead bool) (int64, error) {
+	var keys []string
+	var err error
+	if c.document {
+		err = c.conn.ListNode(ctx, c.root, &keys, &yt.ListNodeOptions{
+			MasterReadOptions: ytsaurusReadOptions(staleRead),
+		})
+	} else {
+		err = c.conn.GetNode(ctx, c.root.Attr("user_attribute_keys"), &keys, &yt.GetNodeOptions{
+			MasterReadOptions: ytsaurusReadOptions(staleRead),
+		})
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	for _, k := range rangeKeys(keys, prefix, limit) {
+		var value []byte
+		if err = c.conn.GetNode(ctx, c.path(k), &value, &yt.GetNodeOptions{
+			MasterReadOptions: ytsaurusReadOptions(staleRead),
+		}); err != nil {
+			return n, err
+		}
+		n += int64(len(k) + len(value))
+	}
+	return n, nil
+}
+
+// Watch is not supported, since Cypress has no change notifications.
+func (c *ytsaurusClient) Watch(ctx context.Context, key string) (<-chan []byte, error) {
+	return nil, errNotSupported
+}
+
+func (c *ytsaurusClient) WatchChildren(ctx context.Context, key string) (<-chan []string, error) {
+	return nil, errNotSupported
+}
+
+// CompareAndSwap sets the key with the node revision read as prerequisite.
+// In attribute storage mode, all keys share the root node revision,
+// so that any concurrent update is a conflict.
+func (c *ytsaurusClient) CompareAndSwap(ctx context.Context, key string, value []byte) (bool, error) {
+	var rev yt.Revision
+	if err := c.conn.GetNode(ctx, c.node(key).Attr("revision"), &rev, &yt.GetNodeOptions{
+		MasterReadOptions: ytsaurusReadOptions(false),
+	}); err != nil {
+		return false, err
+	}
+	err := c.conn.SetNode(ctx, c.path(key), value, &yt.SetNodeOptions{
+		PrerequisiteOptions: &yt.PrerequisiteOptions{
+			Revisions: []yt.PrerequisiteRevision{{Path: c.node(key), Revision: rev}},
+		},
+	})
+	if yterrors.ContainsErrorCode(err, yterrors.CodePrerequisiteCheckFailed) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *ytsaurusClient) Exists(ctx context.Context, key string) (bool, error) {
+	return c.conn.NodeExists(ctx, c.path(key), &yt.NodeExistsOptions{
+		MasterReadOptions: ytsaurusReadOptions(false),
+	})
+}
+
+// Grant is not supported, since Cypress nodes are not bound to liveness.
+func (c *ytsaurusClient) Grant(ctx context.Context, ttl time.Duration) (Lease, error) {
+	return nil, errNotSupported
+}
+
+func getTotalKeysYTsaurus(lg *zap.Logger, flag *dbtesterpb.Flag_Ytsaurus_Cypress) map[string]int64 {
+	rs := make(map[string]int64)
+	if flag == nil {
+		return rs
+	}
+
+	conn, err := createConnYTsaurus(flag)
+	if err != nil {
+		lg.Warn("failed to create YTsaurus client", zap.String("rpc-proxy", flag.RPCProxy), zap.Error(err))
+		rs[flag.RPCProxy] = 0
+		return rs
+	}
+	defer conn.Stop()
+
+	root := ypath.Path(flag.RootPath)
+	switch flag.StorageMode {
+	case ytsaurusStorageDocument:
+		var n int64
+		err = conn.GetNode(context.Background(), root.Attr("count"), &n, nil)
+		rs[flag.RPCProxy] = n
+	default:
+		var keys []string
+		err = conn.GetNode(context.Background(), root.Attr("user_attribute_keys"), &keys, nil)
+		rs[flag.RPCProxy] = int64(len(keys))
+	}
+	if err != nil {
+		lg.Warn("failed to count keys", zap.String("root-path", flag.RootPath), zap.Error(err))
+	}
+
+	lg.Info("getTotalKeysYTsaurus", zap.String("response", fmt.Sprintf("%+v", rs)))
+	return rs
+}
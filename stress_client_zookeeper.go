@@ -16,9 +16,12 @@ package dbtester
 
 import (
 	"errors"
-	"fmt"
+	"net"
+	"sync"
 	"time"
 
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
 	"github.com/samuel/go-zookeeper/zk"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
@@ -29,66 +32,326 @@ var (
 	zkCreateACL   = zk.WorldACL(zk.PermAll)
 )
 
-type zkOp struct {
-	key       string
-	value     []byte
-	staleRead bool
+func init() {
+	RegisterDriver(dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta, zkDriver{})
+	RegisterDriver(dbtesterpb.DatabaseID_zetcd__beta, zkDriver{})
+}
+
+type zkDriver struct{}
+
+func (zkDriver) Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) ([]Client, func(), error) {
+	conns, err := createConnsZk(gcfg.DatabaseEndpoints, ccfg.TotalConns)
+	if err != nil {
+		return nil, nil, err
+	}
+	cs := make([]Client, ccfg.TotalClients)
+	for i := range cs {
+		cs[i] = &zkClient{conn: conns[i%len(conns)], endpoints: gcfg.DatabaseEndpoints, overwrite: ccfg.Overwrite, multi: ccfg.MultiTxn}
+	}
+	// zk.Conn.Close panics when called twice on the shared connection
+	closeConns := func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}
+	return cs, closeConns, nil
+}
+
+func (zkDriver) TotalKeys(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) map[string]int64 {
+	return getTotalKeysZk(lg, gcfg.DatabaseEndpoints)
 }
 
-func mustCreateConnsZk(endpoints []string, total int64) []*zk.Conn {
+// ClassifyError classifies the ZooKeeper client errors.
+func (zkDriver) ClassifyError(err error) string {
+	switch {
+	case errors.Is(err, zk.ErrNoNode):
+		return ErrorNotFound
+	case errors.Is(err, zk.ErrBadVersion), errors.Is(err, zk.ErrNodeExists):
+		return ErrorConflict
+	case errors.Is(err, zk.ErrConnectionClosed), errors.Is(err, zk.ErrSessionExpired), errors.Is(err, zk.ErrSessionMoved):
+		return ErrorConnectionReset
+	case errors.Is(err, zk.ErrNoServer), errors.Is(err, zk.ErrClosing):
+		return ErrorUnavailable
+	}
+	return ""
+}
+
+func createConnsZk(endpoints []string, total int64) ([]*zk.Conn, error) {
 	zks := make([]*zk.Conn, total)
 	for i := range zks {
-		endpoint := endpoints[dialTotal%len(endpoints)]
-		dialTotal++
+		// round-robin over the endpoints
+		endpoint := endpoints[i%len(endpoints)]
 		conn, _, err := zk.Connect([]string{endpoint}, time.Second)
 		if err != nil {
-			panic(err)
+			for j := 0; j < i; j++ {
+				zks[j].Close()
+			}
+			return nil, err
 		}
 		zks[i] = conn
 	}
-	return zks
+	return zks, nil
+}
+
+// zkClient stores keys as znodes under the root.
+type zkClient struct {
+	conn *zk.Conn
+	// endpoints are dialed by new sessions for leases.
+	endpoints []string
+	// overwrite is true to set the existing znodes (samekey),
+	// instead of creating new ones. The znodes that do not exist
+	// yet are still created.
+	overwrite bool
+	// multi is true to check the version and set the znode in "Multi".
+	multi bool
 }
 
-func newPutCreateZK(conn *zk.Conn) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		op := req.zkOp
-		_, err := conn.Create(op.key, op.value, zkCreateFlags, zkCreateACL)
-		return err
+func (c *zkClient) Put(ctx context.Context, key string, value []byte) error {
+	if c.overwrite {
+		_, err := c.conn.Set("/"+key, value, int32(-1))
+		if err != zk.ErrNoNode {
+			return err
+		}
 	}
+	_, err := c.conn.Create("/"+key, value, zkCreateFlags, zkCreateACL)
+	return err
 }
 
-func newPutOverwriteZK(conn *zk.Conn) ReqHandler {
-	// samekey
-	return func(ctx context.Context, req *request) error {
-		op := req.zkOp
-		_, err := conn.Set(op.key, op.value, int32(-1))
-		return err
+func (c *zkClient) Get(ctx context.Context, key string, staleRead bool) ([]byte, error) {
+	if !staleRead {
+		// the read is not linearizable without the sync
+		if _, err := c.conn.Sync("/" + key); err != nil {
+			return nil, err
+		}
 	}
+	data, _, err := c.conn.Get("/" + key)
+	return data, err
+}
+
+func (c *zkClient) Delete(ctx context.Context, key string) error {
+	return c.conn.Delete("/"+key, int32(-1))
 }
 
-func newGetZK(conn *zk.Conn) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		errt := ""
-		if !req.zkOp.staleRead {
-			_, err := conn.Sync("/" + req.zkOp.key)
+// Range lists the root znodes and gets the ones with the prefix,
+// since ZooKeeper does not support prefix reads.
+func (c *zkClient) Range(ctx context.Context, prefix string, limit int64, staleRead bool) (int64, error) {
+	if !staleRead {
+		if _, err := c.conn.Sync("/"); err != nil {
+			return 0, err
+		}
+	}
+	children, _, err := c.conn.Children("/")
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for _, k := range rangeKeys(children, prefix, limit) {
+		data, _, err := c.conn.Get("/" + k)
+		if err != nil {
+			return n, err
+		}
+		n += int64(len(k) + len(data))
+	}
+	return n, nil
+}
+
+// Watch gets the znode and sets a new watch on every notification,
+// since ZooKeeper watches are one-time triggers. Changes between
+// the notification and the next watch are not notified.
+func (c *zkClient) Watch(ctx context.Context, key string) (<-chan []byte, error) {
+	_, _, evc, err := c.conn.GetW("/" + key)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []byte, 1)
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case ev := <-evc:
+				if ev.Err != nil {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+
+			var data []byte
+			data, _, evc, err = c.conn.GetW("/" + key)
 			if err != nil {
-				errt += err.Error()
+				return
+			}
+			select {
+			case ch <- data:
+			case <-ctx.Done():
+				return
 			}
 		}
-		_, _, err := conn.Get("/" + req.zkOp.key)
-		if err != nil {
-			if errt != "" {
-				errt += "; "
+	}()
+	return ch, nil
+}
+
+// WatchChildren lists the children on each "ChildrenW" notification,
+// and sends the ones not listed before.
+func (c *zkClient) WatchChildren(ctx context.Context, key string) (<-chan []string, error) {
+	children, _, evc, err := c.conn.ChildrenW("/" + key)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(children))
+	for _, name := range children {
+		seen[name] = struct{}{}
+	}
+
+	ch := make(chan []string, 1)
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case ev := <-evc:
+				if ev.Err != nil {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+
+			children, _, evc, err = c.conn.ChildrenW("/" + key)
+			if err != nil {
+				return
+			}
+			var names []string
+			for _, name := range children {
+				if _, ok := seen[name]; !ok {
+					seen[name] = struct{}{}
+					names = append(names, name)
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+			select {
+			case ch <- names:
+			case <-ctx.Done():
+				return
 			}
-			errt += fmt.Sprintf("%q while getting %q", err.Error(), "/"+req.zkOp.key)
 		}
-		if errt != "" {
-			return errors.New(errt)
+	}()
+	return ch, nil
+}
+
+// CompareAndSwap sets the znode with the version read, or checks the
+// version and sets the znode in "Multi", either of which fails with
+// zk.ErrBadVersion on a conflict.
+func (c *zkClient) CompareAndSwap(ctx context.Context, key string, value []byte) (bool, error) {
+	_, stat, err := c.conn.Get("/" + key)
+	if err != nil {
+		return false, err
+	}
+	if c.multi {
+		_, err = c.conn.Multi(
+			&zk.CheckVersionRequest{Path: "/" + key, Version: stat.Version},
+			&zk.SetDataRequest{Path: "/" + key, Data: value, Version: -1},
+		)
+	} else {
+		_, err = c.conn.Set("/"+key, value, stat.Version)
+	}
+	if err == zk.ErrBadVersion {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *zkClient) Exists(ctx context.Context, key string) (bool, error) {
+	ok, _, err := c.conn.Exists("/" + key)
+	return ok, err
+}
+
+// Grant creates a new session with the TTL as session timeout,
+// whose keys are ephemeral znodes.
+func (c *zkClient) Grant(ctx context.Context, ttl time.Duration) (Lease, error) {
+	d := &zkLeaseDialer{}
+	conn, evc, err := zk.Connect(c.endpoints, ttl, zk.WithDialer(d.dial), zk.WithLogger(zkNopLogger{}))
+	if err != nil {
+		return nil, err
+	}
+	for {
+		select {
+		case ev := <-evc:
+			if ev.State == zk.StateHasSession {
+				return &zkLease{conn: conn, dialer: d}, nil
+			}
+		case <-ctx.Done():
+			conn.Close()
+			return nil, ctx.Err()
 		}
-		return nil
 	}
 }
 
+type zkLease struct {
+	conn   *zk.Conn
+	dialer *zkLeaseDialer
+}
+
+func (l *zkLease) Put(ctx context.Context, key string, value []byte) error {
+	_, err := l.conn.Create("/"+key, value, zk.FlagEphemeral, zkCreateACL)
+	return err
+}
+
+// KeepAlive sends a request on the session, which refreshes the session
+// as the client pings do.
+func (l *zkLease) KeepAlive(ctx context.Context) error {
+	_, _, err := l.conn.Exists("/")
+	return err
+}
+
+// Expire disconnects the session without closing it, so that the
+// session expires on the server, since the client pings are automatic.
+func (l *zkLease) Expire() {
+	l.dialer.expire()
+}
+
+func (l *zkLease) Close() error {
+	l.conn.Close()
+	return nil
+}
+
+// zkLeaseDialer dials the session connection until the session expires.
+type zkLeaseDialer struct {
+	mu      sync.Mutex
+	expired bool
+	conn    net.Conn
+}
+
+func (d *zkLeaseDialer) dial(network, address string, timeout time.Duration) (net.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.expired {
+		return nil, errors.New("session is expiring")
+	}
+	conn, err := net.DialTimeout(network, address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	d.conn = conn
+	return conn, nil
+}
+
+func (d *zkLeaseDialer) expire() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.expired = true
+	if d.conn != nil {
+		d.conn.Close()
+	}
+}
+
+// zkNopLogger discards the reconnection logs of expiring sessions.
+type zkNopLogger struct{}
+
+func (zkNopLogger) Printf(string, ...interface{}) {}
+
 func getTotalKeysZk(lg *zap.Logger, endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	stats, ok := zk.FLWSrvr(endpoints, 5*time.Second)
@@ -0,0 +1,376 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// loadGeneratorStartDelay is the time for the start request
+// to reach all load generators, before they start in lockstep.
+const loadGeneratorStartDelay = time.Second
+
+// loadGeneratorCancelTimeout is the timeout to cancel the load generators.
+const loadGeneratorCancelTimeout = 10 * time.Second
+
+// loadGeneratorMaxRecvMsgSize is the maximum size of the stats
+// returned by each load generator, with the latency histogram of
+// every second.
+const loadGeneratorMaxRecvMsgSize = 512 * 1024 * 1024
+
+// stressDistributed splits the benchmark across the load generators,
+// starts them at the same time via their agents, and saves the merged stats
+// as if all requests were sent by the control machine. Once 'ctx' is
+// canceled, the load generators stop the requests, and the stats so far
+// are saved.
+func (cfg *Config) stressDistributed(ctx context.Context, databaseID string, gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	// record the seed, so that the run can be reproduced
+	if gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed == 0 {
+		gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed = time.Now().UnixNano()
+	}
+	cfg.lg.Sugar().Infof("random seed %d [database: %q]", gcfg.ConfigClientMachineBenchmarkOptions.RandomSeed, gcfg.DatabaseID)
+
+	parts, offsets := splitLoad(gcfg, len(gcfg.LoadGeneratorEndpoints))
+	reqs := make([]*dbtesterpb.Request, len(parts))
+	for i := range parts {
+		req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_StressPrepare, i)
+		if err != nil {
+			return err
+		}
+		req.ConfigClientMachineAgentControl = &parts[i]
+		req.LoadGeneratorIndex = uint32(i)
+		req.RequestOffset = offsets[i]
+		reqs[i] = req
+	}
+
+	// the first one preloads the keys for all before the others are prepared,
+	// so that the ready ones wait for the start within 'loadGeneratorStartTimeout'
+	cfg.lg.Info("preparing load generators", zap.Strings("endpoints", gcfg.LoadGeneratorEndpoints))
+	if _, err := cfg.sendLoadGenerators(ctx, gcfg.LoadGeneratorEndpoints[:1], reqs[:1]); err != nil {
+		return err
+	}
+	if _, err := cfg.sendLoadGenerators(ctx, gcfg.LoadGeneratorEndpoints[1:], reqs[1:]); err != nil {
+		cfg.cancelLoadGenerators(gcfg, reqs)
+		return err
+	}
+
+	startAt := time.Now().Add(loadGeneratorStartDelay)
+	cfg.lg.Info("starting load generators", zap.Time("start", startAt))
+	for _, req := range reqs {
+		req.Operation = dbtesterpb.Operation_StressStart
+		req.StartUnixNano = startAt.UnixNano()
+	}
+	if _, err := cfg.sendLoadGenerators(ctx, gcfg.LoadGeneratorEndpoints, reqs); err != nil {
+		cfg.cancelLoadGenerators(gcfg, reqs)
+		return err
+	}
+
+	// the results are still returned after the cancel, with the stats so far
+	cfg.lg.Info("waiting for load generators to finish")
+	resultReqs := make([]*dbtesterpb.Request, len(reqs))
+	for i, req := range reqs {
+		copied := *req
+		copied.Operation = dbtesterpb.Operation_StressResult
+		resultReqs[i] = &copied
+	}
+	donec := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			cfg.lg.Warn("canceling load generators to save partial results")
+			cfg.cancelLoadGenerators(gcfg, reqs)
+		case <-donec:
+		}
+	}()
+	resps, err := cfg.sendLoadGenerators(context.Background(), gcfg.LoadGeneratorEndpoints, resultReqs)
+	close(donec)
+	if err != nil {
+		return err
+	}
+	results := make([]loadGeneratorStats, len(resps))
+	for i, resp := range resps {
+		if err = json.Unmarshal(resp.StressStats, &results[i]); err != nil {
+			return fmt.Errorf("invalid stats of load generator %q (%v)", gcfg.LoadGeneratorEndpoints[i], err)
+		}
+	}
+
+	cfg.lg.Info("combining all reports")
+	stats, clientNs, ext, err := mergeLoadGeneratorStats(results)
+	if err != nil {
+		return err
+	}
+	cfg.lg.Sugar().Infof("got total %d data points and total %f seconds (RPS %f)", stats.count(), stats.Total.Seconds(), stats.RPS)
+	printStats(stats)
+	printExtraStats(ext)
+	cfg.saveAllStats(gcfg, stats, clientNs, ext)
+
+	if gcfg.ConfigClientMachineBenchmarkOptions.Type == "write" {
+		drv, err := getDriver(gcfg.DatabaseID)
+		if err != nil {
+			return err
+		}
+		expectedTotal := completedRequests(stats)
+		if !gcfg.ConfigClientMachineBenchmarkOptions.SameKey && !insertsKeys(gcfg.ConfigClientMachineBenchmarkOptions.KeyDistribution) {
+			expectedTotal = gcfg.ConfigClientMachineBenchmarkOptions.KeySpaceSize
+		}
+		cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
+		for k, v := range drv.TotalKeys(cfg.lg, gcfg) {
+			cfg.lg.Sugar().Infof("expected write total results [expected_total: %d | database: %q | endpoint: %q | number_of_keys: %d]",
+				expectedTotal, gcfg.DatabaseID, k, v)
+		}
+	}
+	return nil
+}
+
+// cancelLoadGenerators cancels all load generators, ignoring the errors,
+// so that they stop the requests and can be prepared again.
+func (cfg *Config) cancelLoadGenerators(gcfg dbtesterpb.ConfigClientMachineAgentControl, reqs []*dbtesterpb.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), loadGeneratorCancelTimeout)
+	defer cancel()
+
+	cancelReqs := make([]*dbtesterpb.Request, len(reqs))
+	for i, req := range reqs {
+		copied := *req
+		copied.Operation = dbtesterpb.Operation_StressCancel
+		cancelReqs[i] = &copied
+	}
+	if _, err := cfg.sendLoadGenerators(ctx, gcfg.LoadGeneratorEndpoints, cancelReqs); err != nil {
+		cfg.lg.Warn("failed to cancel load generators", zap.Error(err))
+	}
+}
+
+// sendLoadGenerators sends the requests to the load generators at the same
+// indexes, and returns their responses after all succeed. The requests in
+// flight are canceled with 'ctx', and the agents drop their load generators.
+func (cfg *Config) sendLoadGenerators(ctx context.Context, endpoints []string, reqs []*dbtesterpb.Request) ([]dbtesterpb.Response, error) {
+	type result struct {
+		idx  int
+		resp *dbtesterpb.Response
+		err  error
+	}
+	rc := make(chan result, len(reqs))
+	for i, req := range reqs {
+		go func(i int, ep string, req *dbtesterpb.Request) {
+			cfg.lg.Info("sending message",
+				zap.Int("index", i),
+				zap.String("endpoint", ep),
+				zap.String("operation", req.Operation.String()),
+				zap.String("database", req.DatabaseID.String()),
+			)
+			conn, err := grpc.Dial(ep, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(loadGeneratorMaxRecvMsgSize)))
+			if err != nil {
+				rc <- result{idx: i, err: fmt.Errorf("%v (%q)", err, ep)}
+				return
+			}
+			defer conn.Close()
+
+			// no timeout, since preloading keys or
+			// running the benchmark may take hours
+			resp, err := dbtesterpb.NewTransporterClient(conn).Transfer(ctx, req)
+			if err != nil {
+				err = fmt.Errorf("%v (%q)", err, ep)
+			}
+			rc <- result{idx: i, resp: resp, err: err}
+		}(i, endpoints[i], req)
+	}
+
+	resps := make([]dbtesterpb.Response, len(reqs))
+	var errs []error
+	for range reqs {
+		r := <-rc
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		resps[r.idx] = *r.resp
+	}
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return resps, nil
+}
+
+// splitLoad splits the clients and requests evenly across 'n' load
+// generators, with the remainder to the first ones. It returns the part of
+// each load generator, and the index of the first sequential key it writes.
+func splitLoad(gcfg dbtesterpb.ConfigClientMachineAgentControl, n int) ([]dbtesterpb.ConfigClientMachineAgentControl, []int64) {
+	split := func(v int64, i int) int64 {
+		p := v / int64(n)
+		if int64(i) < v%int64(n) {
+			p++
+		}
+		return p
+	}
+
+	parts := make([]dbtesterpb.ConfigClientMachineAgentControl, n)
+	offsets := make([]int64, n)
+	offset := int64(0)
+	for i := range parts {
+		// copy the options not to overwrite 'gcfg'
+		opts := *gcfg.ConfigClientMachineBenchmarkOptions
+		opts.ClientNumber = split(opts.ClientNumber, i)
+		opts.ConnectionNumber = split(opts.ConnectionNumber, i)
+		opts.ConnectionClientNumbers = make([]int64, len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers))
+		for j, v := range gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers {
+			opts.ConnectionClientNumbers[j] = split(v, i)
+		}
+		opts.RequestNumber = split(opts.RequestNumber, i)
+		opts.WarmupRequests = split(opts.WarmupRequests, i)
+		opts.CooldownRequests = split(opts.CooldownRequests, i)
+		opts.RateLimitRequestsPerSecond = split(opts.RateLimitRequestsPerSecond, i)
+		opts.LoadProfile = nil
+		for _, v := range gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile {
+			st := *v
+			st.RequestsPerSecond = split(st.RequestsPerSecond, i)
+			st.StartRequestsPerSecond = split(st.StartRequestsPerSecond, i)
+			st.AmplitudeRequestsPerSecond = split(st.AmplitudeRequestsPerSecond, i)
+			st.PeakRequestsPerSecond = split(st.PeakRequestsPerSecond, i)
+			opts.LoadProfile = append(opts.LoadProfile, &st)
+		}
+		// different values and keys on each load generator,
+		// still reproducible from the seed
+		opts.RandomSeed += int64(i)
+
+		parts[i] = gcfg
+		parts[i].ConfigClientMachineBenchmarkOptions = &opts
+		if gcfg.Flag_Ytsaurus_Cypress != nil {
+			// the token is not sent over the insecure connection,
+			// and each load generator reads its own
+			flag := *gcfg.Flag_Ytsaurus_Cypress
+			flag.Token = ""
+			parts[i].Flag_Ytsaurus_Cypress = &flag
+		}
+		offsets[i] = offset
+		offset += opts.RequestNumber
+	}
+	return parts, offsets
+}
+
+// mergeConcurrent merges the stats of the requests sent at the same time
+// (e.g. by different load generators).
+func mergeConcurrent(stats []latencyStats) latencyStats {
+	merged := newLatencyStats()
+	for _, st := range stats {
+		total := merged.Total
+		merged.merge(st)
+		if total > st.Total {
+			merged.Total = total
+		} else {
+			merged.Total = st.Total
+		}
+	}
+	merged.update()
+	return merged
+}
+
+// mergeLoadGeneratorStats merges the stats of all load generators,
+// and returns them as saved by 'saveAllStats'.
+func mergeLoadGeneratorStats(results []loadGeneratorStats) (latencyStats, []int64, extraStats, error) {
+	var (
+		ext         extraStats
+		all         = make([]latencyStats, 0, len(results))
+		opStats     = make(map[Op][]latencyStats)
+		uncorrected []latencyStats
+		retried     []latencyStats
+		clientNs    = make(map[int64]int64)
+	)
+	decode := func(e encodedLatencyStats) (latencyStats, error) {
+		st, err := e.decode()
+		if err != nil {
+			return st, err
+		}
+		st.update()
+		return st, nil
+	}
+	for _, r := range results {
+		st, err := decode(r.Stats)
+		if err != nil {
+			return st, nil, ext, err
+		}
+		all = append(all, st)
+		for sec, n := range r.ClientNumbers {
+			clientNs[sec] += n
+		}
+
+		for op, e := range r.OpStats {
+			if st, err = decode(e); err != nil {
+				return st, nil, ext, err
+			}
+			opStats[op] = append(opStats[op], st)
+		}
+		ext.respBytes.merge(respBytesStats{total: r.RespBytes, requests: r.RespBytesRequests, bySecond: r.RespBytesBySecond})
+		ext.conflicts += r.Conflicts
+		if r.Uncorrected != nil {
+			if st, err = decode(*r.Uncorrected); err != nil {
+				return st, nil, ext, err
+			}
+			uncorrected = append(uncorrected, st)
+		}
+		if r.RetryEnabled {
+			if ext.retry == nil {
+				ext.retry = &retryStats{}
+			}
+			ext.retry.requests += r.RetryRequests
+			ext.retry.attempts += r.RetryAttempts
+			ext.retry.retried += r.RetryRetried
+			ext.retry.failed += r.RetryFailed
+			if r.RetryLatency != nil {
+				if st, err = decode(*r.RetryLatency); err != nil {
+					return st, nil, ext, err
+				}
+				retried = append(retried, st)
+			}
+		}
+		ext.interrupted = ext.interrupted || r.Interrupted
+	}
+
+	merged := mergeConcurrent(all)
+	if len(opStats) > 0 {
+		ext.opStats = make(map[Op]latencyStats, len(opStats))
+		for op, sts := range opStats {
+			ext.opStats[op] = mergeConcurrent(sts)
+		}
+	}
+	if len(uncorrected) > 0 {
+		st := mergeConcurrent(uncorrected)
+		ext.uncorrected = &st
+	}
+	if len(retried) > 0 {
+		st := mergeConcurrent(retried)
+		ext.retry.latency = &st
+	}
+
+	if len(clientNs) == 0 {
+		return merged, nil, ext, nil
+	}
+	combinedClientNumber := make([]int64, len(merged.TimeSeries))
+	for i, dp := range merged.TimeSeries {
+		n, ok := clientNs[dp.Timestamp]
+		if !ok && i > 0 {
+			// the seconds without requests
+			n = combinedClientNumber[i-1]
+		}
+		combinedClientNumber[i] = n
+	}
+	return merged, combinedClientNumber, ext, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/etcd-io/etcd/pkg/report"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+func Test_splitLoad(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		LoadGeneratorEndpoints: []string{"a", "b", "c"},
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			ClientNumber:               10,
+			ConnectionNumber:           4,
+			ConnectionClientNumbers:    []int64{3, 7},
+			RequestNumber:              100,
+			WarmupRequests:             10,
+			RateLimitRequestsPerSecond: 50,
+			RandomSeed:                 7,
+		},
+	}
+	parts, offsets := splitLoad(gcfg, 3)
+
+	expected := []dbtesterpb.ConfigClientMachineBenchmarkOptions{
+		{ClientNumber: 4, ConnectionNumber: 2, ConnectionClientNumbers: []int64{1, 3}, RequestNumber: 34, WarmupRequests: 4, RateLimitRequestsPerSecond: 17, RandomSeed: 7},
+		{ClientNumber: 3, ConnectionNumber: 1, ConnectionClientNumbers: []int64{1, 2}, RequestNumber: 33, WarmupRequests: 3, RateLimitRequestsPerSecond: 17, RandomSeed: 8},
+		{ClientNumber: 3, ConnectionNumber: 1, ConnectionClientNumbers: []int64{1, 2}, RequestNumber: 33, WarmupRequests: 3, RateLimitRequestsPerSecond: 16, RandomSeed: 9},
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(*parts[i].ConfigClientMachineBenchmarkOptions, expected[i]) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, expected[i], *parts[i].ConfigClientMachineBenchmarkOptions)
+		}
+	}
+	if !reflect.DeepEqual(offsets, []int64{0, 34, 67}) {
+		t.Fatalf("expected offsets [0 34 67], got %v", offsets)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber != 10 || gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[1] != 7 {
+		t.Fatalf("expected original options unchanged, got %+v", *gcfg.ConfigClientMachineBenchmarkOptions)
+	}
+
+	gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond = 0
+	gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile = []*dbtesterpb.ConfigClientMachineLoadProfileStage{
+		{Type: "ramp", DurationSeconds: 60, StartRequestsPerSecond: 10, RequestsPerSecond: 100},
+		{Type: "sine", DurationSeconds: 60, RequestsPerSecond: 100, AmplitudeRequestsPerSecond: 50, PeriodSeconds: 30},
+	}
+	parts, _ = splitLoad(gcfg, 3)
+	expectedProfile := []dbtesterpb.ConfigClientMachineLoadProfileStage{
+		{Type: "ramp", DurationSeconds: 60, StartRequestsPerSecond: 3, RequestsPerSecond: 33},
+		{Type: "sine", DurationSeconds: 60, RequestsPerSecond: 33, AmplitudeRequestsPerSecond: 16, PeriodSeconds: 30},
+	}
+	if len(parts[2].ConfigClientMachineBenchmarkOptions.LoadProfile) != len(expectedProfile) {
+		t.Fatalf("expected %d stages, got %d", len(expectedProfile), len(parts[2].ConfigClientMachineBenchmarkOptions.LoadProfile))
+	}
+	for j, st := range parts[2].ConfigClientMachineBenchmarkOptions.LoadProfile {
+		if !reflect.DeepEqual(*st, expectedProfile[j]) {
+			t.Fatalf("stage %d: expected %+v, got %+v", j, expectedProfile[j], *st)
+		}
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile[0].RequestsPerSecond != 100 {
+		t.Fatalf("expected original load profile unchanged, got %+v", *gcfg.ConfigClientMachineBenchmarkOptions.LoadProfile[0])
+	}
+
+	gcfg.Flag_Ytsaurus_Cypress = &dbtesterpb.Flag_Ytsaurus_Cypress{RPCProxy: "localhost:9013", Token: "secret"}
+	parts, _ = splitLoad(gcfg, 3)
+	for i := range parts {
+		if flag := parts[i].Flag_Ytsaurus_Cypress; flag.Token != "" || flag.RPCProxy != "localhost:9013" {
+			t.Fatalf("#%d: expected the token not sent, got %+v", i, *flag)
+		}
+	}
+	if gcfg.Flag_Ytsaurus_Cypress.Token != "secret" {
+		t.Fatalf("expected original token unchanged, got %q", gcfg.Flag_Ytsaurus_Cypress.Token)
+	}
+}
+
+func Test_mergeLoadGeneratorStats(t *testing.T) {
+	start := time.Unix(1000, 0)
+	record := func(total time.Duration, begin time.Time, results ...report.Result) latencyStats {
+		r := newLatencyRecorder(latencyPhases{}, begin)
+		donec := r.Stats()
+		for _, res := range results {
+			r.Results() <- res
+		}
+		close(r.Results())
+		st := <-donec
+		st.Total = total
+		return st
+	}
+	result := func(sec int64, lat time.Duration, err error) report.Result {
+		st := start.Add(time.Duration(sec) * time.Second)
+		return report.Result{Start: st, End: st.Add(lat), Err: err}
+	}
+
+	// load generators send the requests at the same time,
+	// and the second one with fewer clients from the second 1002
+	st1 := record(3*time.Second, start.Add(-time.Second),
+		result(0, 10*time.Millisecond, nil),
+		result(1, 20*time.Millisecond, nil),
+		result(2, 30*time.Millisecond, errors.New("fail")),
+	)
+	st2 := record(2*time.Second, start,
+		result(0, 40*time.Millisecond, nil),
+		result(2, 50*time.Millisecond, nil),
+	)
+	encode := func(st latencyStats, clientNs []int64, ext extraStats) loadGeneratorStats {
+		ls, err := newLoadGeneratorStats(st, clientNs, ext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := json.Marshal(ls)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded loadGeneratorStats
+		if err = json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		return decoded
+	}
+	results := []loadGeneratorStats{
+		encode(st1, []int64{2, 2, 2}, extraStats{conflicts: 1, retry: &retryStats{requests: 3, attempts: 4, retried: 1}}),
+		encode(st2, []int64{3, 3, 1}, extraStats{conflicts: 2, retry: &retryStats{requests: 2, attempts: 2}, interrupted: true}),
+	}
+
+	merged, clientNs, ext, err := mergeLoadGeneratorStats(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !merged.begin.Equal(start.Add(-time.Second)) {
+		t.Fatalf("expected the earliest begin of the load generators, got %v", merged.begin)
+	}
+	if merged.Total != 3*time.Second {
+		t.Fatalf("expected total of the longest load generator 3s, got %v", merged.Total)
+	}
+	if completedRequests(merged) != 5 || merged.count() != 4 || merged.ErrorDist[ErrorOther] != 1 {
+		t.Fatalf("expected 5 completed and 4 successful requests, got %d, %d (%v)", completedRequests(merged), merged.count(), merged.ErrorDist)
+	}
+	if merged.Fastest != 0.01 || merged.Slowest != 0.05 || merged.Average != 0.03 {
+		t.Fatalf("unexpected fastest %f, slowest %f, average %f", merged.Fastest, merged.Slowest, merged.Average)
+	}
+	if len(merged.TimeSeries) != 3 || merged.TimeSeries[0].ThroughPut != 2 || merged.TimeSeries[2].ThroughPut != 1 {
+		t.Fatalf("unexpected time series %+v", merged.TimeSeries)
+	}
+	if merged.secondErrorRate(1002) != 0.5 {
+		t.Fatalf("expected error rate 0.5 at second 1002, got %f", merged.secondErrorRate(1002))
+	}
+	// the empty second 1001 of the second one still counts its clients
+	if !reflect.DeepEqual(clientNs, []int64{5, 5, 3}) {
+		t.Fatalf("expected clients [5 5 3], got %v", clientNs)
+	}
+	if ext.conflicts != 3 || !ext.interrupted {
+		t.Fatalf("expected 3 conflicts and interrupted, got %d, %v", ext.conflicts, ext.interrupted)
+	}
+	if ext.retry == nil || ext.retry.requests != 5 || ext.retry.attempts != 6 || ext.retry.retried != 1 {
+		t.Fatalf("unexpected retry stats %+v", ext.retry)
+	}
+}
+
+func Test_waitStartCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg := &Config{lg: zap.NewNop(), part: &loadPart{
+		ctx:    ctx,
+		cancel: cancel,
+		readyc: make(chan struct{}),
+		startc: make(chan struct{}),
+	}}
+
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		cfg.waitStart(cfg.runContext())
+	}()
+	<-cfg.part.readyc
+	// canceled by the control machine before the start
+	cancel()
+	select {
+	case <-donec:
+	case <-time.After(10 * time.Second):
+		t.Fatal("waitStart did not return on the cancel")
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/etcd-io/etcd/pkg/report"
+	"golang.org/x/net/context"
+)
+
+const (
+	// leasePollInterval is how often the key is checked
+	// after its lease is left to expire.
+	leasePollInterval = 10 * time.Millisecond
+
+	// leaseExpiryTimeout is how long to wait for the keys to be deleted
+	// after the expected expiry, in TTLs. Consul invalidates sessions
+	// up to twice the TTL.
+	leaseExpiryTimeout = 3
+)
+
+// leaseStats are the expiry results of "lease" workload.
+type leaseStats struct {
+	leases  int64
+	expired int64
+	// notExpired is the number of leases whose keys are
+	// not deleted until the expiry timeout.
+	notExpired int64
+	// expiryDelays are the seconds between the expected and the observed
+	// deletion of the keys, negative when deleted earlier than expected.
+	expiryDelays []float64
+}
+
+// expiryDelay returns the expiry delay at the percentile (0 to 100),
+// in milliseconds.
+func (ls *leaseStats) expiryDelay(pct float64) float64 {
+	if len(ls.expiryDelays) == 0 {
+		return 0
+	}
+	idx := int(float64(len(ls.expiryDelays)-1) * pct / 100)
+	return 1000 * ls.expiryDelays[idx]
+}
+
+func (ls *leaseStats) averageExpiryDelay() float64 {
+	if len(ls.expiryDelays) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range ls.expiryDelays {
+		sum += v
+	}
+	return 1000 * sum / float64(len(ls.expiryDelays))
+}
+
+// stressLease grants one lease per client with the keys bound to it,
+// keeps the leases alive, and then measures how accurately they expire.
+func (cfg *Config) stressLease(ctx context.Context, drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	clients, closeConns, err := drv.Connect(gcfg, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+	})
+	if err != nil {
+		return err
+	}
+	defer closeConns()
+
+	begin := time.Now()
+	rep := newLatencyRecorder(newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions), begin)
+	repDone := rep.Stats()
+	opReports := make(map[Op]*opReport)
+	for _, op := range []Op{OpLeaseGrant, OpPut, OpLeaseKeepAlive} {
+		r := &opReport{report: newLatencyRecorder(newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions), begin)}
+		r.reportDone = r.report.Stats()
+		opReports[op] = r
+	}
+	record := func(op Op, err error, start time.Time) {
+		if err != nil && ctx.Err() != nil {
+			// not the database failure, but stopped by the cancel
+			return
+		}
+		res := report.Result{Err: err, Start: start, End: time.Now()}
+		rep.Results() <- res
+		opReports[op].report.Results() <- res
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+		ls = &leaseStats{leases: int64(len(clients))}
+	)
+	wg.Add(len(clients))
+	for i := range clients {
+		go func(idx int) {
+			defer wg.Done()
+			delay, expired, err := runLease(ctx, clients[idx], gcfg, int64(idx), vals, record)
+			if err != nil {
+				cfg.lg.Sugar().Infof("lease #%d failed (%v)", idx, err)
+				return
+			}
+			mu.Lock()
+			if expired {
+				ls.expired++
+				ls.expiryDelays = append(ls.expiryDelays, delay.Seconds())
+			} else {
+				ls.notExpired++
+			}
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	sort.Float64s(ls.expiryDelays)
+
+	close(rep.Results())
+	st := <-repDone
+	opStats := make(map[Op]latencyStats, len(opReports))
+	for op, r := range opReports {
+		close(r.report.Results())
+		opStats[op] = <-r.reportDone
+	}
+
+	printStats(st)
+	printOpStats(opStats)
+	printLeaseStats(ls)
+	cfg.saveAllStats(gcfg, st, nil, extraStats{opStats: opStats, lease: ls, interrupted: ctx.Err() != nil})
+	return nil
+}
+
+// runLease grants a lease and puts the keys bound to it, keeps the lease
+// alive, and returns the time between the expected and the observed deletion
+// of the keys. The expected deletion is the TTL after the last refresh.
+func runLease(ctx context.Context, c Client, gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int64, vals values, record func(Op, error, time.Time)) (delay time.Duration, expired bool, err error) {
+	ttl := time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.LeaseTTLSeconds) * time.Second
+
+	st := time.Now()
+	l, err := c.Grant(ctx, ttl)
+	record(OpLeaseGrant, err, st)
+	if err != nil {
+		return 0, false, err
+	}
+	defer l.Close()
+	refreshed := time.Now()
+
+	// each lease has every ClientNumber-th key
+	var keys []string
+	for k := idx; k < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; k += gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber {
+		key := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, k)
+		st = time.Now()
+		err = l.Put(ctx, key, vals.bytes[k%int64(vals.sampleSize)])
+		record(OpPut, err, st)
+		if err != nil {
+			return 0, false, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return 0, false, fmt.Errorf("no key is bound to lease #%d", idx)
+	}
+
+	// refresh 3 times per TTL, as etcd clients do
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+	deadline := time.After(time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.LeaseKeepAliveSeconds) * time.Second)
+	for done := false; !done; {
+		select {
+		case <-deadline:
+			done = true
+		case <-ctx.Done():
+			return 0, false, ctx.Err()
+		case <-ticker.C:
+			st = time.Now()
+			err = l.KeepAlive(ctx)
+			record(OpLeaseKeepAlive, err, st)
+			if err == nil {
+				refreshed = time.Now()
+			}
+		}
+	}
+	l.Expire()
+
+	expected := refreshed.Add(ttl)
+	timeout := expected.Add(leaseExpiryTimeout * ttl)
+	for time.Now().Before(timeout) && ctx.Err() == nil {
+		ok, err := c.Exists(ctx, keys[0])
+		if err == nil && !ok {
+			return time.Since(expected), true, nil
+		}
+		time.Sleep(leasePollInterval)
+	}
+	return 0, false, nil
+}
+
+func printLeaseStats(ls *leaseStats) {
+	fmt.Printf("Leases: %d\n", ls.leases)
+	fmt.Printf("Leases expired: %d\n", ls.expired)
+	fmt.Printf("Leases not expired: %d\n", ls.notExpired)
+	if len(ls.expiryDelays) > 0 {
+		fmt.Printf("Expiry delay (min/avg/max): %.4f/%.4f/%.4f ms\n", ls.expiryDelay(0), ls.averageExpiryDelay(), ls.expiryDelay(100))
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+)
+
+// memLeaseClient is an in-memory Client whose leases delete
+// their keys the TTL after Expire.
+type memLeaseClient struct {
+	Client
+
+	mu sync.Mutex
+	// deleteAt is the deletion time of each key, zero while alive.
+	deleteAt map[string]time.Time
+}
+
+func (c *memLeaseClient) Exists(ctx context.Context, key string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.deleteAt[key]
+	return ok && (t.IsZero() || time.Now().Before(t)), nil
+}
+
+func (c *memLeaseClient) Grant(ctx context.Context, ttl time.Duration) (Lease, error) {
+	return &memLease{c: c, ttl: ttl}, nil
+}
+
+type memLease struct {
+	c    *memLeaseClient
+	ttl  time.Duration
+	keys []string
+}
+
+func (l *memLease) Put(ctx context.Context, key string, value []byte) error {
+	l.c.mu.Lock()
+	l.c.deleteAt[key] = time.Time{}
+	l.c.mu.Unlock()
+	l.keys = append(l.keys, key)
+	return nil
+}
+
+func (l *memLease) KeepAlive(ctx context.Context) error { return nil }
+
+func (l *memLease) Expire() {
+	l.c.mu.Lock()
+	for _, k := range l.keys {
+		l.c.deleteAt[k] = time.Now().Add(l.ttl)
+	}
+	l.c.mu.Unlock()
+}
+
+func (l *memLease) Close() error { return nil }
+
+func Test_runLease(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber:   10,
+			ClientNumber:    2,
+			KeySizeBytes:    8,
+			LeaseTTLSeconds: 1,
+		},
+	}
+	vals := values{bytes: [][]byte{[]byte("v")}, sampleSize: 1}
+	c := &memLeaseClient{deleteAt: make(map[string]time.Time)}
+
+	opN := make(map[Op]int)
+	record := func(op Op, err error, st time.Time) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		opN[op]++
+	}
+	delay, expired, err := runLease(context.Background(), c, gcfg, 1, vals, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !expired {
+		t.Fatal("expected the lease to expire")
+	}
+	if delay < 0 || delay > 100*time.Millisecond {
+		t.Fatalf("unexpected expiry delay %v", delay)
+	}
+	if opN[OpLeaseGrant] != 1 || opN[OpPut] != 5 {
+		t.Fatalf("unexpected requests %+v", opN)
+	}
+}
+
+func Test_leaseStats(t *testing.T) {
+	ls := &leaseStats{expiryDelays: []float64{-0.001, 0.001, 0.002, 0.003, 0.010}}
+	if v := ls.expiryDelay(0); v != -1 {
+		t.Fatalf("expected min -1 ms, got %f", v)
+	}
+	if v := ls.expiryDelay(50); v != 2 {
+		t.Fatalf("expected p50 2 ms, got %f", v)
+	}
+	if v := ls.expiryDelay(100); v != 10 {
+		t.Fatalf("expected max 10 ms, got %f", v)
+	}
+	if v := ls.averageExpiryDelay(); v < 2.9999 || v > 3.0001 {
+		t.Fatalf("expected average 3 ms, got %f", v)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	mrand "math/rand"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+)
+
+// defaultMinThroughputPercent is the lowest throughput, in percentage of
+// the probed rate, for the rate to be sustained.
+const defaultMinThroughputPercent = 90
+
+// SLOSearchPath returns the path of the probes of the SLO search,
+// next to the latency summary file.
+func SLOSearchPath(summaryPath string) string {
+	ext := filepath.Ext(summaryPath)
+	return strings.TrimSuffix(summaryPath, ext) + "-slo-search.csv"
+}
+
+// sloProbe is the result of running a request rate.
+type sloProbe struct {
+	rps int64
+	// latency is at 'LatencyPercentile' of successful requests
+	latency      time.Duration
+	errorPercent float64
+	// throughput is the successful requests per second
+	throughput float64
+	passed     bool
+
+	gcfg  dbtesterpb.ConfigClientMachineAgentControl
+	stats latencyStats
+}
+
+// newSLOProbe evaluates the stats of the rate against the thresholds.
+func newSLOProbe(s *dbtesterpb.ConfigClientMachineSLOSearch, rps int64, st latencyStats) sloProbe {
+	p := sloProbe{rps: rps, throughput: st.RPS}
+	if n := st.completed(); n > 0 {
+		p.errorPercent = float64(n-st.count()) / float64(n) * 100
+	}
+	if st.count() == 0 {
+		// nothing succeeded
+		return p
+	}
+	p.latency = time.Duration(st.percentile(s.LatencyPercentile) * float64(time.Second))
+
+	minThroughput := s.MinThroughputPercent
+	if minThroughput == 0 {
+		minThroughput = defaultMinThroughputPercent
+	}
+	p.passed = toMillisecond(p.latency) <= s.MaxLatencyMilliseconds &&
+		p.errorPercent <= s.MaxErrorPercent &&
+		p.throughput >= float64(rps)*minThroughput/100
+	return p
+}
+
+// searchRate returns the highest rate between 'MinRequestsPerSecond' and
+// 'MaxRequestsPerSecond' that passes the probe, or 0 if none passes.
+// It assumes that a rate passes if any higher rate passes.
+func searchRate(ctx context.Context, s *dbtesterpb.ConfigClientMachineSLOSearch, probe func(rps int64) bool) int64 {
+	lo, hi := s.MinRequestsPerSecond, s.MaxRequestsPerSecond
+	if !probe(lo) {
+		return 0
+	}
+	if ctx.Err() == nil && probe(hi) {
+		return hi
+	}
+	for hi-lo > s.ResolutionRequestsPerSecond && ctx.Err() == nil {
+		mid := lo + (hi-lo)/2
+		if mid == lo {
+			break
+		}
+		if probe(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// searchSLO runs the probes of increasing and decreasing rates, and saves
+// the probes and the stats of the highest sustained rate.
+func (cfg *Config) searchSLO(ctx context.Context, drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, rnd *mrand.Rand) error {
+	s := gcfg.ConfigClientMachineBenchmarkOptions.SLOSearch
+
+	// handlers and requests of each probe, with the probe options
+	var newProbe func(probe dbtesterpb.ConfigClientMachineAgentControl, offset int64) ([]ReqHandler, func(), func(context.Context, chan<- Request))
+	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
+	case "write":
+		keys, err := cfg.prepareWrites(drv, gcfg, vals, rnd)
+		if err != nil {
+			return err
+		}
+		overwrite := gcfg.ConfigClientMachineBenchmarkOptions.SameKey || keys != nil
+		newProbe = func(probe dbtesterpb.ConfigClientMachineAgentControl, offset int64) ([]ReqHandler, func(), func(context.Context, chan<- Request)) {
+			h, done := newClientHandlers(cfg.lg, drv, probe, overwrite, true)
+			return h, done, func(ctx context.Context, inflightReqs chan<- Request) {
+				generateWrites(ctx, probe, offset, vals, keys, inflightReqs)
+			}
+		}
+
+	case "read":
+		key, keys, err := cfg.prepareReads(drv, gcfg, vals, rnd)
+		if err != nil {
+			return err
+		}
+		newProbe = func(probe dbtesterpb.ConfigClientMachineAgentControl, offset int64) ([]ReqHandler, func(), func(context.Context, chan<- Request)) {
+			h, done := newClientHandlers(cfg.lg, drv, probe, false, false)
+			return h, done, func(ctx context.Context, inflightReqs chan<- Request) {
+				generateReads(ctx, probe, key, keys, inflightReqs)
+			}
+		}
+
+	default:
+		return fmt.Errorf("%q does not support SLO search", gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	}
+
+	var probes []sloProbe
+	offset := int64(0)
+	answer := searchRate(ctx, s, func(rps int64) bool {
+		probe := gcfg
+		// copy the options not to overwrite 'gcfg'
+		opts := *gcfg.ConfigClientMachineBenchmarkOptions
+		opts.RateLimitRequestsPerSecond = rps
+		opts.RequestNumber = 0
+		opts.DurationSeconds = s.ProbeSeconds
+		probe.ConfigClientMachineBenchmarkOptions = &opts
+
+		cfg.lg.Sugar().Infof("probing %d requests per second for %d seconds [database: %q]", rps, s.ProbeSeconds, gcfg.DatabaseID)
+		h, done, reqGen := newProbe(probe, offset)
+		b := newBenchmark(ctx, 0, opts.ClientNumber, newLatencyPhases(&opts), requestTimeout(probe), newRetryPolicy(&opts), h, done, reqGen)
+		cfg.liveMetrics.begin(b, probe)
+		b.startRequests()
+		b.waitRequestsEnd()
+		b.finishReports()
+		// sequential keys continue from the previous probe
+		offset += completedRequests(b.stats)
+
+		p := newSLOProbe(s, rps, b.stats)
+		p.gcfg, p.stats = probe, b.stats
+		probes = append(probes, p)
+		cfg.lg.Sugar().Infof("probed %d requests per second [throughput: %.1f | p%g latency: %v | error: %.2f%% | passed: %v | database: %q]",
+			rps, p.throughput, s.LatencyPercentile, p.latency, p.errorPercent, p.passed, gcfg.DatabaseID)
+		return p.passed && ctx.Err() == nil
+	})
+	if len(probes) == 0 {
+		return ctx.Err()
+	}
+	cfg.lg.Sugar().Infof("highest sustained rate %d requests per second [database: %q]", answer, gcfg.DatabaseID)
+
+	// the full results of the highest sustained rate,
+	// or of the last probe if none passed
+	best := probes[len(probes)-1]
+	for _, p := range probes {
+		if p.rps == answer && p.passed {
+			best = p
+		}
+	}
+	printStats(best.stats)
+	cfg.saveAllStats(best.gcfg, best.stats, nil, extraStats{interrupted: ctx.Err() != nil})
+	cfg.saveSLOSearch(gcfg, probes, answer)
+	return nil
+}
+
+// saveSLOSearch saves the probes in order, and the highest sustained
+// rate in the last row ("MAX-SUSTAINED", 0 if none passed).
+func (cfg *Config) saveSLOSearch(gcfg dbtesterpb.ConfigClientMachineAgentControl, probes []sloProbe, answer int64) {
+	s := gcfg.ConfigClientMachineBenchmarkOptions.SLOSearch
+	c1 := dataframe.NewColumn("DATABASE-ID")
+	c2 := dataframe.NewColumn("PROBE")
+	c3 := dataframe.NewColumn("TARGET-RATE")
+	c4 := dataframe.NewColumn("AVG-THROUGHPUT")
+	c5 := dataframe.NewColumn(fmt.Sprintf("P%g-LATENCY-MS", s.LatencyPercentile))
+	c6 := dataframe.NewColumn("ERROR-PERCENT")
+	c7 := dataframe.NewColumn("PASSED")
+	push := func(name string, p sloProbe) {
+		c1.PushBack(dataframe.NewStringValue(gcfg.DatabaseID))
+		c2.PushBack(dataframe.NewStringValue(name))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", p.rps)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", p.throughput)))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(p.latency))))
+		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", p.errorPercent)))
+		c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%v", p.passed)))
+	}
+	var best sloProbe
+	for i, p := range probes {
+		push(fmt.Sprintf("%d", i+1), p)
+		if p.rps == answer && p.passed {
+			best = p
+		}
+	}
+	push("MAX-SUSTAINED", best)
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5, c6, c7} {
+		if err := fr.AddColumn(col); err != nil {
+			panic(err)
+		}
+	}
+	if err := fr.CSV(SLOSearchPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/etcd-io/etcd/pkg/report"
+	"golang.org/x/net/context"
+)
+
+func Test_searchRate(t *testing.T) {
+	s := &dbtesterpb.ConfigClientMachineSLOSearch{
+		MinRequestsPerSecond:        100,
+		MaxRequestsPerSecond:        1000,
+		ResolutionRequestsPerSecond: 50,
+	}
+	tests := []struct {
+		capacity int64
+		answer   int64
+		probes   []int64
+	}{
+		{730, 718, []int64{100, 1000, 550, 775, 662, 718, 746}},
+		{50, 0, []int64{100}},
+		{5000, 1000, []int64{100, 1000}},
+	}
+	for i, tt := range tests {
+		var probes []int64
+		answer := searchRate(context.Background(), s, func(rps int64) bool {
+			probes = append(probes, rps)
+			return rps <= tt.capacity
+		})
+		if answer != tt.answer {
+			t.Fatalf("#%d: expected %d, got %d", i, tt.answer, answer)
+		}
+		if !reflect.DeepEqual(probes, tt.probes) {
+			t.Fatalf("#%d: expected probes %v, got %v", i, tt.probes, probes)
+		}
+	}
+
+	// stops on cancel, with the highest rate passed so far
+	ctx, cancel := context.WithCancel(context.Background())
+	var probes []int64
+	answer := searchRate(ctx, s, func(rps int64) bool {
+		probes = append(probes, rps)
+		if len(probes) == 3 {
+			cancel()
+			return false
+		}
+		return rps < 500
+	})
+	if answer != 100 || len(probes) != 3 {
+		t.Fatalf("expected 100 after 3 probes, got %d after %v", answer, probes)
+	}
+}
+
+func Test_newSLOProbe(t *testing.T) {
+	s := &dbtesterpb.ConfigClientMachineSLOSearch{
+		LatencyPercentile:      99,
+		MaxLatencyMilliseconds: 50,
+		MaxErrorPercent:        5,
+	}
+	stats := func(lat time.Duration, n, errN int) latencyStats {
+		st := newLatencyStats()
+		start := time.Unix(1000, 0)
+		for i := 0; i < n; i++ {
+			st.record(report.Result{Start: start, End: start.Add(lat)})
+		}
+		for i := 0; i < errN; i++ {
+			st.record(report.Result{Err: errors.New("fail"), Start: start, End: start})
+		}
+		st.Total = time.Second
+		st.update()
+		return st
+	}
+	tests := []struct {
+		rps    int64
+		st     latencyStats
+		passed bool
+	}{
+		{100, stats(10*time.Millisecond, 100, 0), true},
+		// too slow
+		{100, stats(100*time.Millisecond, 100, 0), false},
+		// too many errors
+		{100, stats(10*time.Millisecond, 90, 10), false},
+		// not sustained under 90% of the rate
+		{200, stats(10*time.Millisecond, 100, 0), false},
+		{100, stats(10*time.Millisecond, 0, 0), false},
+	}
+	for i, tt := range tests {
+		if p := newSLOProbe(s, tt.rps, tt.st); p.passed != tt.passed {
+			t.Fatalf("#%d: expected passed %v, got %+v", i, tt.passed, p)
+		}
+	}
+
+	p := newSLOProbe(s, 100, stats(10*time.Millisecond, 95, 5))
+	if !p.passed || p.errorPercent != 5 || p.throughput != 95 {
+		t.Fatalf("expected passed with 5%% errors and 95 throughput, got %+v", p)
+	}
+}
+
+func TestSLOSearchPath(t *testing.T) {
+	if p := SLOSearchPath("a/etcd-client-latency-distribution-summary.csv"); p != "a/etcd-client-latency-distribution-summary-slo-search.csv" {
+		t.Fatalf("unexpected path %q", p)
+	}
+}
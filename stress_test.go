@@ -0,0 +1,453 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	mrand "math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+func Test_generateMixed(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber: 10000,
+			KeySizeBytes:  8,
+			KeySpaceSize:  100,
+			ReadPercent:   90,
+		},
+	}
+	vals := values{bytes: [][]byte{[]byte("v")}, sampleSize: 1}
+
+	rnd := mrand.New(mrand.NewSource(1))
+	keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionSequential, rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := make(chan Request, 100)
+	go generateMixed(context.Background(), gcfg, vals, keys, rnd, reqs)
+
+	opN := make(map[Op]int)
+	for req := range reqs {
+		opN[req.Op]++
+		if req.Key >= sequentialKey(8, 100) {
+			t.Fatalf("key %q is out of key space", req.Key)
+		}
+		if req.Op == OpPut && string(req.Value) != "v" {
+			t.Fatalf("unexpected value %q", req.Value)
+		}
+	}
+	if opN[OpGet]+opN[OpPut] != 10000 {
+		t.Fatalf("expected 10000 requests, got %+v", opN)
+	}
+	if opN[OpGet] < 8500 || opN[OpGet] > 9500 {
+		t.Fatalf("expected about 9000 reads, got %d", opN[OpGet])
+	}
+}
+
+func Test_generateDeletes(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber: 100,
+			KeySizeBytes:  8,
+		},
+	}
+
+	reqs := make(chan Request, 10)
+	go generateDeletes(context.Background(), gcfg, reqs)
+
+	i := int64(0)
+	for req := range reqs {
+		if req.Op != OpDelete {
+			t.Fatalf("#%d: expected %v, got %v", i, OpDelete, req.Op)
+		}
+		if req.Key != sequentialKey(8, i) {
+			t.Fatalf("#%d: expected key %q, got %q", i, sequentialKey(8, i), req.Key)
+		}
+		i++
+	}
+	if i != 100 {
+		t.Fatalf("expected 100 requests, got %d", i)
+	}
+}
+
+func Test_generateTxns(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber: 1000,
+			KeySizeBytes:  8,
+			KeySpaceSize:  3,
+		},
+	}
+	vals := values{bytes: [][]byte{[]byte("v")}, sampleSize: 1}
+
+	keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, keyDistributionUniform, mrand.New(mrand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := make(chan Request, 10)
+	go generateTxns(context.Background(), gcfg, vals, keys, reqs)
+
+	keySet := make(map[string]struct{})
+	for req := range reqs {
+		if req.Op != OpTxn {
+			t.Fatalf("expected %v, got %v", OpTxn, req.Op)
+		}
+		keySet[req.Key] = struct{}{}
+	}
+	if len(keySet) != 3 {
+		t.Fatalf("expected 3 contended keys, got %d", len(keySet))
+	}
+}
+
+func Test_generateMixedLatest(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber:   1000,
+			KeySizeBytes:    8,
+			KeySpaceSize:    100,
+			ReadPercent:     50,
+			KeyDistribution: keyDistributionLatest,
+			ZipfianSkew:     defaultZipfianSkew,
+		},
+	}
+	vals := values{bytes: [][]byte{[]byte("v")}, sampleSize: 1}
+
+	rnd := mrand.New(mrand.NewSource(1))
+	keys, err := newKeyIndexGenerator(gcfg.ConfigClientMachineBenchmarkOptions, "", rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := make(chan Request, 10)
+	go generateMixed(context.Background(), gcfg, vals, keys, rnd, reqs)
+
+	// each write creates the next key, and reads never go past it
+	next := int64(100)
+	for req := range reqs {
+		switch req.Op {
+		case OpPut:
+			if req.Key != sequentialKey(8, next) {
+				t.Fatalf("expected new key %q, got %q", sequentialKey(8, next), req.Key)
+			}
+			next++
+		case OpGet:
+			if req.Key >= sequentialKey(8, next) {
+				t.Fatalf("key %q is not written yet", req.Key)
+			}
+		}
+	}
+	if next == 100 {
+		t.Fatal("expected new keys")
+	}
+}
+
+func Test_generateReadsDuration(t *testing.T) {
+	tests := []struct {
+		requestN, durationSeconds int64
+		// minimum and maximum number of requests
+		min, max int64
+	}{
+		{100, 0, 100, 100},
+		{100, 60, 100, 100},
+		{0, 1, 1000, -1},
+		{1 << 40, 1, 1000, 1<<40 - 1},
+	}
+	for i, tt := range tests {
+		gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+			ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+				RequestNumber:   tt.requestN,
+				DurationSeconds: tt.durationSeconds,
+				KeySizeBytes:    8,
+			},
+		}
+
+		start := time.Now()
+		reqs := make(chan Request, 10)
+		go generateReads(context.Background(), gcfg, "foo", nil, reqs)
+
+		n := int64(0)
+		for range reqs {
+			n++
+		}
+		if n < tt.min || (tt.max >= 0 && n > tt.max) {
+			t.Fatalf("#%d: unexpected %d requests", i, n)
+		}
+		if tt.durationSeconds > 0 && tt.requestN != 100 {
+			// generous upper bound for the slow test machines,
+			// still far below sending all 1<<40 requests
+			if took := time.Since(start); took < time.Second || took > 30*time.Second {
+				t.Fatalf("#%d: expected to end on 1-second deadline, took %v", i, took)
+			}
+		}
+	}
+}
+
+func Test_requestPacerOpenLoop(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RateLimitRequestsPerSecond: 100,
+			OpenLoop:                   true,
+		},
+	}
+	p := newRequestPacer(context.Background(), gcfg)
+	first := p.wait(0)
+	// falls behind the schedule
+	time.Sleep(100 * time.Millisecond)
+	for i := int64(1); i <= 5; i++ {
+		if intended := p.wait(i); intended.Sub(first) != time.Duration(i)*10*time.Millisecond {
+			t.Fatalf("#%d: expected to be scheduled %v after the first, got %v", i, time.Duration(i)*10*time.Millisecond, intended.Sub(first))
+		}
+	}
+
+	gcfg.ConfigClientMachineBenchmarkOptions.OpenLoop = false
+	if intended := newRequestPacer(context.Background(), gcfg).wait(0); !intended.IsZero() {
+		t.Fatalf("expected no scheduled time in closed loop, got %v", intended)
+	}
+}
+
+func Test_benchmarkOpenLoop(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber:              20,
+			ClientNumber:               1,
+			KeySizeBytes:               8,
+			RateLimitRequestsPerSecond: 100,
+			OpenLoop:                   true,
+		},
+	}
+	// each request takes 5 times the scheduled interval
+	h := []ReqHandler{func(ctx context.Context, req *Request) error {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	}}
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateReads(ctx, gcfg, "foo", nil, inflightReqs)
+	}
+	b := newBenchmark(context.Background(), 20, 1, latencyPhases{}, 0, retryPolicy{}, h, nil, reqGen)
+	b.startRequests()
+	b.waitAll()
+
+	if b.uncorrectedStats == nil {
+		t.Fatal("expected uncorrected stats")
+	}
+	if b.uncorrectedStats.Slowest > 0.1 {
+		t.Fatalf("expected uncorrected latency of about 50ms, got %f secs", b.uncorrectedStats.Slowest)
+	}
+	// the last request is scheduled at 190ms, but sent at about 950ms
+	if b.stats.Slowest < 0.5 {
+		t.Fatalf("expected corrected latency to include the queueing time, got %f secs", b.stats.Slowest)
+	}
+}
+
+func Test_extraStatsMerge(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber:              10,
+			ClientNumber:               1,
+			KeySizeBytes:               8,
+			RateLimitRequestsPerSecond: 1000,
+			OpenLoop:                   true,
+		},
+	}
+	h := []ReqHandler{func(ctx context.Context, req *Request) error { return nil }}
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateReads(ctx, gcfg, "foo", nil, inflightReqs)
+	}
+
+	// runs of two client number ranges
+	var ext extraStats
+	for i := 0; i < 2; i++ {
+		b := newBenchmark(context.Background(), 10, 1, latencyPhases{}, 0, retryPolicy{}, h, nil, reqGen)
+		b.startRequests()
+		b.waitAll()
+		ext.merge(b)
+	}
+	ext.update()
+
+	if ext.uncorrected == nil || ext.uncorrected.count() != 20 {
+		t.Fatalf("expected uncorrected stats of 20 requests, got %+v", ext.uncorrected)
+	}
+	if st, ok := ext.opStats[OpGet]; !ok || st.count() != 20 {
+		t.Fatalf("expected GET stats of 20 requests, got %v", ext.opStats)
+	}
+	if ext.retry != nil {
+		t.Fatalf("expected no retry stats without retry policy, got %+v", ext.retry)
+	}
+}
+
+func Test_benchmarkRequestTimeout(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber: 10,
+			ClientNumber:  1,
+			KeySizeBytes:  8,
+		},
+	}
+	// the first request hangs until the end, ignoring the context
+	release := make(chan struct{})
+	defer close(release)
+	var n int64
+	h := []ReqHandler{func(ctx context.Context, req *Request) error {
+		if atomic.AddInt64(&n, 1) == 1 {
+			<-release
+		}
+		return nil
+	}}
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateReads(ctx, gcfg, "foo", nil, inflightReqs)
+	}
+	b := newBenchmark(context.Background(), 10, 1, latencyPhases{}, 20*time.Millisecond, retryPolicy{}, h, nil, reqGen)
+	now := time.Now()
+	b.startRequests()
+	b.waitAll()
+
+	if took := time.Since(now); took > 10*time.Second {
+		t.Fatalf("expected hanging requests to time out, took %v", took)
+	}
+	// the later requests wait for the abandoned one, without being sent
+	if b.stats.ErrorDist[ErrorTimeout] != 10 || atomic.LoadInt64(&n) != 1 {
+		t.Fatalf("expected 10 timeouts with 1 request sent, got %v and %d", b.stats.ErrorDist, atomic.LoadInt64(&n))
+	}
+}
+
+func Test_benchmarkDo(t *testing.T) {
+	b := &benchmark{ctx: context.Background(), timeout: 20 * time.Millisecond}
+	release := make(chan struct{})
+	var abandoned chan error
+	err := b.do(func(ctx context.Context, req *Request) error {
+		<-release
+		return nil
+	}, &Request{}, &abandoned)
+	if err != errRequestTimeout || abandoned == nil {
+		t.Fatalf("expected %v with the call abandoned, got %v", errRequestTimeout, err)
+	}
+
+	sent := false
+	send := func(ctx context.Context, req *Request) error {
+		sent = true
+		return nil
+	}
+	if err = b.do(send, &Request{}, &abandoned); err != errRequestTimeout || sent {
+		t.Fatalf("expected %v before sending, got %v (sent %v)", errRequestTimeout, err, sent)
+	}
+	close(release)
+	if err = b.do(send, &Request{}, &abandoned); err != nil || !sent || abandoned != nil {
+		t.Fatalf("expected sent after the abandoned call, got %v (sent %v)", err, sent)
+	}
+}
+
+func Test_benchmarkCancel(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber: 1000000,
+			ClientNumber:  2,
+			KeySizeBytes:  8,
+		},
+	}
+	h := []ReqHandler{
+		func(ctx context.Context, req *Request) error {
+			time.Sleep(time.Millisecond)
+			return nil
+		},
+		func(ctx context.Context, req *Request) error {
+			time.Sleep(time.Millisecond)
+			return nil
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateReads(ctx, gcfg, "foo", nil, inflightReqs)
+	}
+	b := newBenchmark(ctx, 1000000, 2, latencyPhases{}, 0, retryPolicy{}, h, nil, reqGen)
+	b.startRequests()
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	donec := make(chan struct{})
+	go func() {
+		b.waitAll()
+		close(donec)
+	}()
+	select {
+	case <-donec:
+	case <-time.After(5 * time.Second):
+		t.Fatal("took too long to stop the canceled benchmark")
+	}
+
+	// the requests before the cancel are reported, without errors
+	if n := b.stats.count(); n == 0 || n >= 1000000 {
+		t.Fatalf("expected partial requests, got %d", n)
+	}
+	if len(b.stats.ErrorDist) != 0 {
+		t.Fatalf("expected no errors from the cancel, got %v", b.stats.ErrorDist)
+	}
+}
+
+func Test_benchmarkRetry(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber:            6,
+			ClientNumber:             1,
+			KeySizeBytes:             8,
+			RetryMaxAttempts:         3,
+			RetryBackoffMilliseconds: 10,
+		},
+	}
+	// every attempt fails but the third one
+	var n int64
+	h := []ReqHandler{func(ctx context.Context, req *Request) error {
+		if atomic.AddInt64(&n, 1)%3 != 0 {
+			return categorizeError(zkDriver{}, zk.ErrConnectionClosed)
+		}
+		return nil
+	}}
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateReads(ctx, gcfg, "foo", nil, inflightReqs)
+	}
+	b := newBenchmark(context.Background(), 6, 1, latencyPhases{}, 0, newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions), h, nil, reqGen)
+	b.startRequests()
+	b.waitAll()
+
+	rs := b.retryStats
+	if rs == nil || rs.requests != 6 || rs.attempts != 18 || rs.retried != 6 || rs.failed != 0 {
+		t.Fatalf("expected 6 requests with 18 attempts, all retried, got %+v", rs)
+	}
+	if len(b.stats.ErrorDist) != 0 || b.stats.count() != 6 {
+		t.Fatalf("expected 6 successes, got %v and %d", b.stats.ErrorDist, b.stats.count())
+	}
+	// the retried latency includes the backoffs of 10ms and 20ms
+	if rs.latency == nil || rs.latency.count() != 6 || rs.latency.Fastest < 0.03 {
+		t.Fatalf("expected retried latency with 30ms backoff, got %+v", rs.latency)
+	}
+
+	// with 2 attempts, the requests failing twice eventually fail
+	atomic.StoreInt64(&n, 0)
+	gcfg.ConfigClientMachineBenchmarkOptions.RetryMaxAttempts = 2
+	b = newBenchmark(context.Background(), 6, 1, latencyPhases{}, 0, newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions), h, nil, reqGen)
+	b.startRequests()
+	b.waitAll()
+	rs = b.retryStats
+	if rs.attempts != 9 || rs.retried != 3 || rs.failed != 3 || b.stats.ErrorDist[ErrorConnectionReset] != 3 {
+		t.Fatalf("expected 9 attempts, 3 retried and eventually failed, got %+v, %v", rs, b.stats.ErrorDist)
+	}
+}
@@ -0,0 +1,403 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/etcd-io/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// watchDrainTimeout is how long to wait for the remaining notifications
+// after all writes are done.
+const watchDrainTimeout = 5 * time.Second
+
+// watchStats are the notification counts of "watch" workload.
+type watchStats struct {
+	watchers int64
+	// expected is the number of successful writes times the number of watchers.
+	expected   int64
+	received   int64
+	missed     int64
+	duplicated int64
+
+	// writes are the stats of the writes that trigger notifications.
+	writes latencyStats
+}
+
+// watchValue stamps the value with the writer ID, its sequence number
+// and the time the write is sent, padded to 'size' bytes.
+func watchValue(writer, seq int64, sent time.Time, size int64) []byte {
+	v := []byte(fmt.Sprintf("%d %d %d ", writer, seq, sent.UnixNano()))
+	if int64(len(v)) < size {
+		v = append(v, bytes.Repeat([]byte("a"), int(size)-len(v))...)
+	}
+	return v
+}
+
+func parseWatchValue(v []byte) (writer, seq int64, sent time.Time, err error) {
+	fs := bytes.SplitN(v, []byte(" "), 4)
+	if len(fs) < 4 {
+		return 0, 0, time.Time{}, fmt.Errorf("unexpected watch value %q", v)
+	}
+	var ns int64
+	for i, p := range []*int64{&writer, &seq, &ns} {
+		if *p, err = strconv.ParseInt(string(fs[i]), 10, 64); err != nil {
+			return 0, 0, time.Time{}, fmt.Errorf("unexpected watch value %q (%v)", v, err)
+		}
+	}
+	return writer, seq, time.Unix(0, ns), nil
+}
+
+const (
+	// watchModeKey watches the changes of the key.
+	watchModeKey = "key"
+	// watchModeChildren watches the children created under the key.
+	watchModeChildren = "children"
+)
+
+// watchChildName stamps the name of the child created by the write,
+// as 'watchValue' stamps the value.
+func watchChildName(writer, seq int64, sent time.Time) string {
+	return fmt.Sprintf("%d-%d-%d", writer, seq, sent.UnixNano())
+}
+
+func parseWatchChildName(name []byte) (writer, seq int64, sent time.Time, err error) {
+	fs := bytes.Split(name, []byte("-"))
+	if len(fs) != 3 {
+		return 0, 0, time.Time{}, fmt.Errorf("unexpected watch child name %q", name)
+	}
+	var ns int64
+	for i, p := range []*int64{&writer, &seq, &ns} {
+		if *p, err = strconv.ParseInt(string(fs[i]), 10, 64); err != nil {
+			return 0, 0, time.Time{}, fmt.Errorf("unexpected watch child name %q (%v)", name, err)
+		}
+	}
+	return writer, seq, time.Unix(0, ns), nil
+}
+
+// watchStamps watches the key, or its children with 'children', and
+// sends the stamped values, or the stamped names of the new children.
+func watchStamps(ctx context.Context, c Client, key string, children bool) (<-chan []byte, error) {
+	if !children {
+		return c.Watch(ctx, key)
+	}
+	nch, err := c.WatchChildren(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan []byte, 1)
+	go func() {
+		defer close(ch)
+		for names := range nch {
+			for _, name := range names {
+				select {
+				case ch <- []byte(name):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// watchRound is the result of "watch" workload with a number of watchers.
+type watchRound struct {
+	stats latencyStats
+	watch *watchStats
+	retry *retryStats
+}
+
+// stressWatch measures the latency from sending a write to the key
+// to receiving its notification on every watcher, once for each
+// number of watchers in 'WatcherNumbers'.
+func (cfg *Config) stressWatch(ctx context.Context, drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+	if err := cfg.writeKey(drv, gcfg, key, watchValue(0, 0, time.Now(), gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)); err != nil {
+		return err
+	}
+
+	numbers := gcfg.ConfigClientMachineBenchmarkOptions.WatcherNumbers
+	if len(numbers) == 0 {
+		numbers = []int64{gcfg.ConfigClientMachineBenchmarkOptions.WatcherNumber}
+	}
+	var rounds []watchRound
+	for _, n := range numbers {
+		copied := gcfg
+		opts := *gcfg.ConfigClientMachineBenchmarkOptions
+		opts.WatcherNumber = n
+		copied.ConfigClientMachineBenchmarkOptions = &opts
+
+		r, err := cfg.watchRound(ctx, drv, copied, key)
+		if err != nil {
+			return err
+		}
+		rounds = append(rounds, r)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	r := rounds[0]
+	if len(gcfg.ConfigClientMachineBenchmarkOptions.WatcherNumbers) > 0 {
+		cfg.saveWatchRounds(gcfg, rounds)
+		r = mergeWatchRounds(rounds)
+	}
+	printStats(r.stats)
+	printWatchStats(r.watch)
+	printRetryStats(r.retry)
+	cfg.saveAllStats(gcfg, r.stats, nil, extraStats{watch: r.watch, retry: r.retry, interrupted: ctx.Err() != nil})
+	return nil
+}
+
+// watchRound runs "watch" workload with 'WatcherNumber' watchers.
+func (cfg *Config) watchRound(ctx context.Context, drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, key string) (watchRound, error) {
+	children := gcfg.ConfigClientMachineBenchmarkOptions.WatchMode == watchModeChildren
+	parse := parseWatchValue
+	if children {
+		parse = parseWatchChildName
+	}
+
+	watchers, closeWatchers, err := drv.Connect(gcfg, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.WatcherNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.WatcherNumber,
+	})
+	if err != nil {
+		return watchRound{}, err
+	}
+	defer closeWatchers()
+
+	// cancels the watches after the writes, or with the run
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wchs := make([]<-chan []byte, len(watchers))
+	for i := range watchers {
+		if wchs[i], err = watchStamps(wctx, watchers[i], key, children); err != nil {
+			return watchRound{}, fmt.Errorf("failed to watch %q on %q (%v)", key, gcfg.DatabaseID, err)
+		}
+	}
+
+	// the writes begin once all watchers are registered
+	rep := newLatencyRecorder(newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions), time.Now())
+	repDone := rep.Stats()
+
+	var (
+		wg                   sync.WaitGroup
+		received, duplicated int64
+		lastNotified         int64
+	)
+	for _, wch := range wchs {
+		wg.Add(1)
+		go func(wch <-chan []byte) {
+			defer wg.Done()
+			// last sequence number of each writer
+			last := make(map[int64]int64)
+			for v := range wch {
+				now := time.Now()
+				atomic.StoreInt64(&lastNotified, now.UnixNano())
+				writer, seq, sent, err := parse(v)
+				if err != nil {
+					rep.Results() <- report.Result{Err: err, Start: now, End: now}
+					continue
+				}
+				if seq <= last[writer] {
+					atomic.AddInt64(&duplicated, 1)
+					continue
+				}
+				last[writer] = seq
+				atomic.AddInt64(&received, 1)
+				rep.Results() <- report.Result{Start: sent, End: now}
+			}
+		}(wch)
+	}
+	cfg.lg.Sugar().Infof("registered %d watchers [key: %q | mode: %q | database: %q]", len(watchers), key, gcfg.ConfigClientMachineBenchmarkOptions.WatchMode, gcfg.DatabaseID)
+
+	h, done := newWatchWriteHandlers(cfg.lg, drv, gcfg, children)
+	reqGen := func(ctx context.Context, inflightReqs chan<- Request) {
+		generateWatchWrites(ctx, gcfg, key, inflightReqs)
+	}
+	b := newBenchmark(ctx, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, newLatencyPhases(gcfg.ConfigClientMachineBenchmarkOptions), requestTimeout(gcfg), newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions), h, done, reqGen)
+	b.trace = cfg.trace
+	cfg.liveMetrics.begin(b, gcfg)
+	b.startRequests()
+	b.waitAll()
+
+	// wait for the notifications of the last writes
+	writesEnd := time.Now().UnixNano()
+	expected := b.stats.succeeded() * int64(len(watchers))
+	for atomic.LoadInt64(&received) < expected && ctx.Err() == nil {
+		quiet := atomic.LoadInt64(&lastNotified)
+		if quiet < writesEnd {
+			quiet = writesEnd
+		}
+		if time.Since(time.Unix(0, quiet)) > watchDrainTimeout {
+			cfg.lg.Sugar().Infof("no notification for %v, %d out of %d received", watchDrainTimeout, atomic.LoadInt64(&received), expected)
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	cancel()
+	wg.Wait()
+
+	close(rep.Results())
+	st := <-repDone
+
+	ws := &watchStats{
+		watchers:   int64(len(watchers)),
+		expected:   expected,
+		received:   received,
+		duplicated: duplicated,
+		writes:     b.stats,
+	}
+	if ws.expected > ws.received {
+		ws.missed = ws.expected - ws.received
+	}
+	return watchRound{stats: st, watch: ws, retry: b.retryStats}, nil
+}
+
+// mergeWatchRounds merges the rounds run one after another, with the
+// largest number of watchers and the notification counts of all rounds.
+func mergeWatchRounds(rounds []watchRound) watchRound {
+	merged := watchRound{stats: newLatencyStats(), watch: &watchStats{writes: newLatencyStats()}}
+	for _, r := range rounds {
+		merged.stats.merge(r.stats)
+		if r.watch.watchers > merged.watch.watchers {
+			merged.watch.watchers = r.watch.watchers
+		}
+		merged.watch.expected += r.watch.expected
+		merged.watch.received += r.watch.received
+		merged.watch.missed += r.watch.missed
+		merged.watch.duplicated += r.watch.duplicated
+		merged.watch.writes.merge(r.watch.writes)
+		if r.retry != nil {
+			if merged.retry == nil {
+				merged.retry = &retryStats{}
+			}
+			merged.retry.merge(r.retry)
+		}
+	}
+	merged.stats.update()
+	merged.watch.writes.update()
+	if merged.retry != nil && merged.retry.latency != nil {
+		merged.retry.latency.update()
+	}
+	return merged
+}
+
+// WatchRoundsPath returns the path of the results of each number of
+// watchers with 'WatcherNumbers', next to the latency summary file.
+func WatchRoundsPath(summaryPath string) string {
+	ext := filepath.Ext(summaryPath)
+	return strings.TrimSuffix(summaryPath, ext) + "-watchers.csv"
+}
+
+func (cfg *Config) saveWatchRounds(gcfg dbtesterpb.ConfigClientMachineAgentControl, rounds []watchRound) {
+	c1 := dataframe.NewColumn("WATCHERS")
+	c2 := dataframe.NewColumn("WATCH-EXPECTED-EVENTS")
+	c3 := dataframe.NewColumn("WATCH-RECEIVED-EVENTS")
+	c4 := dataframe.NewColumn("WATCH-MISSED-EVENTS")
+	c5 := dataframe.NewColumn("WATCH-DUPLICATED-EVENTS")
+	c6 := dataframe.NewColumn("AVG-LATENCY-MS")
+	c7 := dataframe.NewColumn("P50-LATENCY-MS")
+	c8 := dataframe.NewColumn("P99-LATENCY-MS")
+	c9 := dataframe.NewColumn("MAX-LATENCY-MS")
+	for _, r := range rounds {
+		c1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.watch.watchers)))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.watch.expected)))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.watch.received)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.watch.missed)))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.watch.duplicated)))
+		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*r.stats.Average)))
+		c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*r.stats.percentile(50))))
+		c8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*r.stats.percentile(99))))
+		c9.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", 1000*r.stats.Slowest)))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5, c6, c7, c8, c9} {
+		if err := fr.AddColumn(col); err != nil {
+			panic(err)
+		}
+	}
+	fpath := WatchRoundsPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
+	if err := fr.CSV(fpath); err != nil {
+		panic(err)
+	}
+	cfg.lg.Sugar().Infof("saved results of %d watcher numbers to %q", len(rounds), fpath)
+}
+
+func printWatchStats(ws *watchStats) {
+	fmt.Printf("Watchers: %d\n", ws.watchers)
+	fmt.Printf("Notifications expected: %d\n", ws.expected)
+	fmt.Printf("Notifications received: %d\n", ws.received)
+	fmt.Printf("Notifications missed: %d\n", ws.missed)
+	fmt.Printf("Notifications duplicated: %d\n", ws.duplicated)
+	fmt.Printf("Write requests/sec: %4.4f\n", ws.writes.RPS)
+}
+
+// newWatchWriteHandlers returns handlers that write values stamped with
+// the sequence number of each handler and the time the write is sent,
+// to the key, or to the new children of the key with 'children'.
+func newWatchWriteHandlers(lg *zap.Logger, drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, children bool) (rhs []ReqHandler, done func()) {
+	clients, closeConns, err := drv.Connect(gcfg, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		Overwrite:    !children,
+	})
+	if err != nil {
+		lg.Sugar().Fatalf("failed to connect to %q (%v)", gcfg.DatabaseID, err)
+	}
+	rhs = make([]ReqHandler, len(clients))
+	for i := range clients {
+		// each handler runs requests one by one
+		writer, c, seq := int64(i), clients[i], int64(0)
+		rhs[i] = func(ctx context.Context, req *Request) error {
+			seq++
+			sent := time.Now()
+			key := req.Key
+			if children {
+				key += "/" + watchChildName(writer, seq, sent)
+			}
+			return c.Put(ctx, key, watchValue(writer, seq, sent, gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes))
+		}
+	}
+	return rhs, closeConns
+}
+
+func generateWatchWrites(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := newRequestPacer(ctx, gcfg)
+
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		intended := pacer.wait(i)
+		inflightReqs <- Request{Op: OpPut, Key: key, Intended: intended}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/etcd-io/etcd/pkg/report"
+)
+
+func Test_watchValue(t *testing.T) {
+	sent := time.Unix(0, 1486389257000000123)
+	for _, size := range []int64{0, 1024} {
+		v := watchValue(7, 12, sent, size)
+		if size > 0 && int64(len(v)) != size {
+			t.Fatalf("expected %d bytes, got %d", size, len(v))
+		}
+		writer, seq, st, err := parseWatchValue(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if writer != 7 || seq != 12 || !st.Equal(sent) {
+			t.Fatalf("unexpected writer %d, seq %d, sent %v", writer, seq, st)
+		}
+	}
+	if _, _, _, err := parseWatchValue([]byte("aaaa")); err == nil {
+		t.Fatal("expected error for unstamped value")
+	}
+}
+
+func Test_watchChildName(t *testing.T) {
+	sent := time.Unix(0, 1486389257000000123)
+	writer, seq, st, err := parseWatchChildName([]byte(watchChildName(7, 12, sent)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if writer != 7 || seq != 12 || !st.Equal(sent) {
+		t.Fatalf("unexpected writer %d, seq %d, sent %v", writer, seq, st)
+	}
+	for _, name := range []string{"aaaa", "7-12", "7-x-1"} {
+		if _, _, _, err = parseWatchChildName([]byte(name)); err == nil {
+			t.Fatalf("expected error for %q", name)
+		}
+	}
+}
+
+func Test_mergeWatchRounds(t *testing.T) {
+	start := time.Unix(1000, 0)
+	round := func(watchers int64, lat time.Duration) watchRound {
+		r := newLatencyRecorder(latencyPhases{}, time.Time{})
+		donec := r.Stats()
+		for i := int64(0); i < watchers; i++ {
+			r.Results() <- report.Result{Start: start, End: start.Add(lat)}
+		}
+		close(r.Results())
+		st := <-donec
+		return watchRound{
+			stats: st,
+			watch: &watchStats{watchers: watchers, expected: watchers + 1, received: watchers, missed: 1, writes: newLatencyStats()},
+		}
+	}
+	merged := mergeWatchRounds([]watchRound{round(1, time.Millisecond), round(4, 10*time.Millisecond)})
+	if merged.watch.watchers != 4 || merged.watch.expected != 7 || merged.watch.received != 5 || merged.watch.missed != 2 {
+		t.Fatalf("unexpected merged watch stats %+v", *merged.watch)
+	}
+	if merged.stats.count() != 5 || merged.stats.Fastest != 0.001 || merged.stats.Slowest != 0.01 {
+		t.Fatalf("expected 5 notifications from 1ms to 10ms, got %d, %f, %f", merged.stats.count(), merged.stats.Fastest, merged.stats.Slowest)
+	}
+	if merged.retry != nil {
+		t.Fatalf("expected no retry stats, got %+v", *merged.retry)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// TracePath returns the path of the request trace recorded by
+// 'RecordTrace', next to the latency summary file.
+func TracePath(summaryPath string) string {
+	ext := filepath.Ext(summaryPath)
+	return strings.TrimSuffix(summaryPath, ext) + "-trace.csv"
+}
+
+// traceHeader is the header of the trace file. The values are not
+// recorded, only the size and the FNV-1a hash, so that the replay
+// sends the same value for the same hash.
+var traceHeader = []string{"OFFSET-NANOSECONDS", "OP", "KEY", "VALUE-SIZE", "VALUE-HASH", "STALE-READ", "LIMIT"}
+
+// traceEntry is a request in the trace.
+type traceEntry struct {
+	// offset is the time since the first request.
+	offset    time.Duration
+	op        Op
+	key       string
+	valueSize int64
+	valueHash uint64
+	staleRead bool
+	limit     int64
+}
+
+func parseOp(s string) (Op, error) {
+	for op := OpPut; op <= OpLeaseKeepAlive; op++ {
+		if op.String() == s {
+			return op, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown operation %q", s)
+}
+
+// traceRecorder writes the requests to the trace file as they are sent,
+// in the order of the clients taking them, which is close to the order
+// of the times. It is safe for concurrent use.
+type traceRecorder struct {
+	mu    sync.Mutex
+	f     *os.File
+	bw    *bufio.Writer
+	w     *csv.Writer
+	start time.Time
+	n     int64
+	// err is the first write error, after which
+	// no more requests are recorded
+	err error
+}
+
+func newTraceRecorder(fpath string) (*traceRecorder, error) {
+	f, err := os.Create(fpath)
+	if err != nil {
+		return nil, err
+	}
+	bw := bufio.NewWriter(f)
+	t := &traceRecorder{f: f, bw: bw, w: csv.NewWriter(bw)}
+	if err = t.w.Write(traceHeader); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// record records the request sent at the time, or scheduled at
+// 'Intended' in open-loop mode. It is a no-op on nil recorder.
+func (t *traceRecorder) record(req Request, sent time.Time) {
+	if t == nil {
+		return
+	}
+	if !req.Intended.IsZero() {
+		sent = req.Intended
+	}
+	hash := ""
+	if req.Op == OpPut || req.Op == OpTxn {
+		hash = strconv.FormatUint(hashValue(req.Value), 16)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil {
+		return
+	}
+	if t.start.IsZero() {
+		t.start = sent
+	}
+	offset := sent.Sub(t.start)
+	if offset < 0 {
+		// sent by another client just before the first one recorded
+		offset = 0
+	}
+	if err := t.w.Write([]string{
+		strconv.FormatInt(int64(offset), 10),
+		req.Op.String(),
+		req.Key,
+		strconv.Itoa(len(req.Value)),
+		hash,
+		strconv.FormatBool(req.StaleRead),
+		strconv.FormatInt(req.Limit, 10),
+	}); err != nil {
+		t.err = err
+		return
+	}
+	t.n++
+}
+
+// close flushes and closes the trace file, and returns the number of
+// requests recorded, with the first write error if any.
+func (t *traceRecorder) close() (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil {
+		t.f.Close()
+		return t.n, t.err
+	}
+	t.w.Flush()
+	if err := t.w.Error(); err != nil {
+		t.f.Close()
+		return t.n, err
+	}
+	if err := t.bw.Flush(); err != nil {
+		t.f.Close()
+		return t.n, err
+	}
+	return t.n, t.f.Close()
+}
+
+// traceReader reads the trace entries in the file order.
+type traceReader struct {
+	r    *csv.Reader
+	line int
+}
+
+func newTraceReader(r io.Reader) (*traceReader, error) {
+	cr := csv.NewReader(bufio.NewReader(r))
+	cr.FieldsPerRecord = len(traceHeader)
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace header (%v)", err)
+	}
+	if !reflect.DeepEqual(header, traceHeader) {
+		return nil, fmt.Errorf("unexpected trace header %q", header)
+	}
+	return &traceReader{r: cr, line: 1}, nil
+}
+
+// next returns the next entry, or io.EOF at the end of the trace.
+func (tr *traceReader) next() (e traceEntry, err error) {
+	row, err := tr.r.Read()
+	if err != nil {
+		return e, err
+	}
+	tr.line++
+
+	var offset int64
+	if offset, err = strconv.ParseInt(row[0], 10, 64); err != nil || offset < 0 {
+		return e, fmt.Errorf("trace line %d got invalid offset %q", tr.line, row[0])
+	}
+	e.offset = time.Duration(offset)
+	if e.op, err = parseOp(row[1]); err != nil {
+		return e, fmt.Errorf("trace line %d got %v", tr.line, err)
+	}
+	switch e.op {
+	case OpPut, OpGet, OpDelete, OpRange, OpTxn:
+	default:
+		return e, fmt.Errorf("trace line %d got %v, not supported for replay", tr.line, e.op)
+	}
+	e.key = row[2]
+	if e.valueSize, err = strconv.ParseInt(row[3], 10, 64); err != nil || e.valueSize < 0 {
+		return e, fmt.Errorf("trace line %d got invalid value size %q", tr.line, row[3])
+	}
+	if row[4] != "" {
+		if e.valueHash, err = strconv.ParseUint(row[4], 16, 64); err != nil {
+			return e, fmt.Errorf("trace line %d got invalid value hash %q", tr.line, row[4])
+		}
+	}
+	if e.staleRead, err = strconv.ParseBool(row[5]); err != nil {
+		return e, fmt.Errorf("trace line %d got invalid stale read %q", tr.line, row[5])
+	}
+	if e.limit, err = strconv.ParseInt(row[6], 10, 64); err != nil {
+		return e, fmt.Errorf("trace line %d got invalid limit %q", tr.line, row[6])
+	}
+	return e, nil
+}
+
+// countTrace validates the trace file, and returns the number of entries.
+func countTrace(fpath string) (int64, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	tr, err := newTraceReader(f)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	for {
+		if _, err = tr.next(); err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+}
+
+// replayValues returns the values of the trace entries. The values of
+// the same hash are the same, and the others are random of the size.
+type replayValues struct {
+	rnd             *mrand.Rand
+	compressibility float64
+	byHash          map[uint64][]byte
+}
+
+func (rv *replayValues) value(e traceEntry) []byte {
+	if e.valueHash == 0 && e.valueSize == 0 {
+		return nil
+	}
+	if v, ok := rv.byHash[e.valueHash]; ok && int64(len(v)) == e.valueSize {
+		return v
+	}
+	v := randValue(rv.rnd, e.valueSize, rv.compressibility)
+	rv.byHash[e.valueHash] = v
+	return v
+}
+
+// generateReplay sends the requests of the trace at the recorded times
+// divided by 'ReplaySpeedup', regardless of the responses, as in open-loop
+// mode. The requests carry the scheduled send time, so that the latency
+// includes the time queued behind slow requests. It ends on the first
+// invalid entry, which is validated by 'countTrace' before the run.
+func generateReplay(ctx context.Context, lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl, tr *traceReader, rnd *mrand.Rand, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	speedup := gcfg.ConfigClientMachineBenchmarkOptions.ReplaySpeedup
+	if speedup <= 0 {
+		speedup = 1
+	}
+	vals := &replayValues{
+		rnd:             rnd,
+		compressibility: gcfg.ConfigClientMachineBenchmarkOptions.ValueCompressibility,
+		byHash:          make(map[uint64][]byte),
+	}
+
+	start := time.Now()
+	lim := newRequestLimit(ctx, gcfg)
+	for i := int64(0); lim.more(i); i++ {
+		e, err := tr.next()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			lg.Warn("stopped replaying trace", zap.Int64("requests", i), zap.Error(err))
+			return
+		}
+
+		intended := start.Add(time.Duration(float64(e.offset) / speedup))
+		if d := time.Until(intended); d > 0 {
+			select {
+			case <-time.After(d):
+			case <-ctx.Done():
+				return
+			}
+		}
+		inflightReqs <- Request{
+			Op:        e.op,
+			Key:       e.key,
+			Value:     vals.value(e),
+			StaleRead: e.staleRead,
+			Limit:     e.limit,
+			Intended:  intended,
+		}
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+func Test_traceRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "trace-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := TracePath(filepath.Join(dir, "summary.csv"))
+	tr, err := newTraceRecorder(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Unix(1000, 0)
+	tr.record(Request{Op: OpPut, Key: "a", Value: []byte("xyz")}, start)
+	// scheduled in open-loop mode
+	tr.record(Request{Op: OpGet, Key: "a", StaleRead: true, Intended: start.Add(time.Millisecond)}, start.Add(time.Second))
+	tr.record(Request{Op: OpRange, Key: "p", Limit: 10}, start.Add(2*time.Millisecond))
+	// sent just before the first one recorded
+	tr.record(Request{Op: OpTxn, Key: "a", Value: []byte("xyz")}, start.Add(-time.Microsecond))
+	var nilRecorder *traceRecorder
+	nilRecorder.record(Request{Op: OpDelete, Key: "a"}, start)
+	n, err := tr.close()
+	if err != nil || n != 4 {
+		t.Fatalf("expected 4 requests recorded, got %d (%v)", n, err)
+	}
+
+	if n, err = countTrace(fpath); err != nil || n != 4 {
+		t.Fatalf("expected 4 requests, got %d (%v)", n, err)
+	}
+	f, err := os.Open(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r, err := newTraceReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := hashValue([]byte("xyz"))
+	expected := []traceEntry{
+		{offset: 0, op: OpPut, key: "a", valueSize: 3, valueHash: hash},
+		{offset: time.Millisecond, op: OpGet, key: "a", staleRead: true},
+		{offset: 2 * time.Millisecond, op: OpRange, key: "p", limit: 10},
+		{offset: 0, op: OpTxn, key: "a", valueSize: 3, valueHash: hash},
+	}
+	for i, exp := range expected {
+		e, err := r.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(e, exp) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, exp, e)
+		}
+	}
+	if _, err = r.next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func Test_traceRecorder_writeError(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "trace-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tr, err := newTraceRecorder(TracePath(filepath.Join(dir, "summary.csv")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// fails to write once the buffer is full
+	tr.f.Close()
+	for i := 0; i < 10000; i++ {
+		tr.record(Request{Op: OpPut, Key: "a", Value: []byte("xyz")}, time.Now())
+	}
+	n, err := tr.close()
+	if err == nil || n == 0 || n >= 10000 {
+		t.Fatalf("expected the write error after some requests, got %d (%v)", n, err)
+	}
+}
+
+func Test_traceReader_invalid(t *testing.T) {
+	header := strings.Join(traceHeader, ",") + "\n"
+	tests := []string{
+		"",
+		"OP,KEY\n",
+		header + "-1,PUT,a,1,0,false,0\n",
+		header + "0,PUTS,a,1,0,false,0\n",
+		header + "0,LEASE-GRANT,a,0,,false,0\n",
+		header + "0,PUT,a,x,0,false,0\n",
+		header + "0,PUT,a,1,xyz,false,0\n",
+		header + "0,PUT,a,1\n",
+	}
+	for i, s := range tests {
+		r, err := newTraceReader(bytes.NewBufferString(s))
+		if err == nil {
+			_, err = r.next()
+		}
+		if err == nil || err == io.EOF {
+			t.Fatalf("#%d: expected error, got %v", i, err)
+		}
+	}
+}
+
+func Test_generateReplay(t *testing.T) {
+	trace := strings.Join(traceHeader, ",") + "\n" +
+		"0,PUT,a,4,1,false,0\n" +
+		"20000000,PUT,b,4,1,false,0\n" +
+		"40000000,GET,a,0,,true,0\n" +
+		"60000000,PUT,c,8,2,false,0\n"
+	r, err := newTraceReader(bytes.NewBufferString(trace))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			RequestNumber: 3,
+			ReplaySpeedup: 2,
+		},
+	}
+	inflightReqs := make(chan Request, 4)
+	start := time.Now()
+	generateReplay(context.Background(), zap.NewNop(), gcfg, r, mrand.New(mrand.NewSource(1)), inflightReqs)
+
+	var reqs []Request
+	for req := range inflightReqs {
+		reqs = append(reqs, req)
+	}
+	if len(reqs) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(reqs))
+	}
+	if reqs[0].Op != OpPut || reqs[1].Op != OpPut || reqs[2].Op != OpGet || !reqs[2].StaleRead {
+		t.Fatalf("unexpected requests %+v", reqs)
+	}
+	// the same hash replays the same value
+	if len(reqs[0].Value) != 4 || !bytes.Equal(reqs[0].Value, reqs[1].Value) || reqs[2].Value != nil {
+		t.Fatalf("unexpected values %q, %q, %q", reqs[0].Value, reqs[1].Value, reqs[2].Value)
+	}
+	// twice faster than recorded
+	if d := reqs[2].Intended.Sub(reqs[0].Intended); d != 20*time.Millisecond {
+		t.Fatalf("expected 20ms between the first and the last, got %v", d)
+	}
+	if took := time.Since(start); took < 20*time.Millisecond {
+		t.Fatalf("expected to wait for the last request, took %v", took)
+	}
+
+	// ends on the invalid entry
+	r, err = newTraceReader(bytes.NewBufferString(strings.Join(traceHeader, ",") + "\n" +
+		"0,PUT,a,4,1,false,0\n" +
+		"1,PUTS,b,4,1,false,0\n" +
+		"2,PUT,c,4,1,false,0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inflightReqs = make(chan Request, 3)
+	gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber = 3
+	generateReplay(context.Background(), zap.NewNop(), gcfg, r, mrand.New(mrand.NewSource(1)), inflightReqs)
+	if n := len(inflightReqs); n != 1 {
+		t.Fatalf("expected 1 request before the invalid entry, got %d", n)
+	}
+}
@@ -53,6 +53,18 @@ func assignRequest(ranges []int64, total int64) (rs []int64) {
 	return
 }
 
+// assignDuration splits the total seconds evenly over the ranges,
+// with the remainder in the last range.
+func assignDuration(ranges []int64, totalSeconds int64) (ds []int64) {
+	each := totalSeconds / int64(len(ranges))
+	ds = make([]int64, len(ranges))
+	for i := range ranges {
+		ds[i] = each
+	}
+	ds[len(ds)-1] += totalSeconds - each*int64(len(ranges))
+	return
+}
+
 func toFile(txt, fpath string) error {
 	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_TRUNC, 0777)
 	if err != nil {
@@ -36,3 +36,11 @@ func Test_assignRequest(t *testing.T) {
 		t.Fatalf("sum must be %d, got %d", total, cur)
 	}
 }
+
+func Test_assignDuration(t *testing.T) {
+	ds := assignDuration([]int64{1, 10, 100}, 100)
+	expected := []int64{33, 33, 34}
+	if !reflect.DeepEqual(ds, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, ds)
+	}
+}
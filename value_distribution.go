@@ -0,0 +1,177 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	mrand "math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+const (
+	// valueSizeFixed makes all values 'ValueSizeBytes'.
+	valueSizeFixed = "fixed"
+	// valueSizeUniform draws sizes between 'ValueSizeMinBytes'
+	// and 'ValueSizeMaxBytes' with equal probability.
+	valueSizeUniform = "uniform"
+	// valueSizeNormal draws sizes around 'ValueSizeBytes'
+	// with 'ValueSizeStddevBytes'.
+	valueSizeNormal = "normal"
+	// valueSizeHistogram draws sizes from the weighted sizes
+	// in 'ValueSizeHistogramPath'.
+	valueSizeHistogram = "histogram"
+
+	defaultValuePoolSize = 1000
+)
+
+func isValidValueSizeDistribution(dist string) bool {
+	switch dist {
+	case valueSizeFixed, valueSizeUniform, valueSizeNormal, valueSizeHistogram:
+		return true
+	}
+	return false
+}
+
+// valueSizeGenerator draws value sizes in bytes.
+type valueSizeGenerator interface {
+	next() int64
+}
+
+func newValueSizeGenerator(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, rnd *mrand.Rand) (valueSizeGenerator, error) {
+	switch opts.ValueSizeDistribution {
+	case "", valueSizeFixed:
+		return fixedSizeGenerator(opts.ValueSizeBytes), nil
+	case valueSizeUniform:
+		return &uniformSizeGenerator{rnd: rnd, min: opts.ValueSizeMinBytes, max: opts.ValueSizeMaxBytes}, nil
+	case valueSizeNormal:
+		return &normalSizeGenerator{
+			rnd:    rnd,
+			mean:   float64(opts.ValueSizeBytes),
+			stddev: float64(opts.ValueSizeStddevBytes),
+			min:    opts.ValueSizeMinBytes,
+			max:    opts.ValueSizeMaxBytes,
+		}, nil
+	case valueSizeHistogram:
+		f, err := os.Open(opts.ValueSizeHistogramPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return readHistogramSizeGenerator(f, rnd)
+	}
+	return nil, fmt.Errorf("unknown value size distribution %q", opts.ValueSizeDistribution)
+}
+
+type fixedSizeGenerator int64
+
+func (g fixedSizeGenerator) next() int64 { return int64(g) }
+
+type uniformSizeGenerator struct {
+	rnd      *mrand.Rand
+	min, max int64
+}
+
+func (g *uniformSizeGenerator) next() int64 {
+	return g.min + g.rnd.Int63n(g.max-g.min+1)
+}
+
+type normalSizeGenerator struct {
+	rnd          *mrand.Rand
+	mean, stddev float64
+	// min and max clamp the sizes, 0 'max' for no upper bound.
+	min, max int64
+}
+
+func (g *normalSizeGenerator) next() int64 {
+	n := int64(math.Round(g.rnd.NormFloat64()*g.stddev + g.mean))
+	if n < g.min {
+		n = g.min
+	}
+	if g.max > 0 && n > g.max {
+		n = g.max
+	}
+	return n
+}
+
+type histogramSizeGenerator struct {
+	rnd   *mrand.Rand
+	sizes []int64
+	// cumWeights are the cumulative weights of 'sizes'.
+	cumWeights []float64
+}
+
+// readHistogramSizeGenerator reads "size_bytes,weight" lines,
+// skipping empty lines and the lines starting with '#'.
+func readHistogramSizeGenerator(f *os.File, rnd *mrand.Rand) (*histogramSizeGenerator, error) {
+	g := &histogramSizeGenerator{rnd: rnd}
+	sum := 0.0
+	sc := bufio.NewScanner(f)
+	for ln := 1; sc.Scan(); ln++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fs := strings.Split(line, ",")
+		if len(fs) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected \"size_bytes,weight\", got %q", f.Name(), ln, line)
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(fs[0]), 10, 64)
+		if err != nil || size < 0 {
+			return nil, fmt.Errorf("%s:%d: invalid size %q", f.Name(), ln, fs[0])
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(fs[1]), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("%s:%d: invalid weight %q", f.Name(), ln, fs[1])
+		}
+		sum += weight
+		g.sizes = append(g.sizes, size)
+		g.cumWeights = append(g.cumWeights, sum)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if sum == 0 {
+		return nil, fmt.Errorf("%s: no weighted size", f.Name())
+	}
+	return g, nil
+}
+
+func (g *histogramSizeGenerator) next() int64 {
+	w := g.rnd.Float64() * g.cumWeights[len(g.cumWeights)-1]
+	return g.sizes[sort.Search(len(g.cumWeights), func(i int) bool { return g.cumWeights[i] > w })]
+}
+
+// randValue returns a value whose last 'compressibility' fraction
+// is a repeated byte, and the rest random letters.
+func randValue(rnd *mrand.Rand, size int64, compressibility float64) []byte {
+	const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	b := make([]byte, size)
+	randN := size - int64(float64(size)*compressibility)
+	for i := range b {
+		if int64(i) < randN {
+			b[i] = letterBytes[rnd.Intn(len(letterBytes))]
+		} else {
+			b[i] = 'a'
+		}
+	}
+	return b
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"compress/flate"
+	"io/ioutil"
+	mrand "math/rand"
+	"os"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func Test_newValues(t *testing.T) {
+	f, err := ioutil.TempFile("", "value-size-histogram")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.WriteString("# size_bytes,weight\n10,1\n\n100,0\n1000,3\n"); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	tests := []struct {
+		opts     dbtesterpb.ConfigClientMachineBenchmarkOptions
+		poolSize int
+		min, max int
+	}{
+		{
+			opts:     dbtesterpb.ConfigClientMachineBenchmarkOptions{ValueSizeBytes: 1024},
+			poolSize: 1, min: 1024, max: 1024,
+		},
+		{
+			opts:     dbtesterpb.ConfigClientMachineBenchmarkOptions{ValueSizeBytes: 1024, ValuePoolSize: 10},
+			poolSize: 10, min: 1024, max: 1024,
+		},
+		{
+			opts:     dbtesterpb.ConfigClientMachineBenchmarkOptions{ValueSizeDistribution: valueSizeUniform, ValueSizeMinBytes: 10, ValueSizeMaxBytes: 20},
+			poolSize: defaultValuePoolSize, min: 10, max: 20,
+		},
+		{
+			opts:     dbtesterpb.ConfigClientMachineBenchmarkOptions{ValueSizeDistribution: valueSizeNormal, ValueSizeBytes: 100, ValueSizeStddevBytes: 50, ValueSizeMinBytes: 1, ValueSizeMaxBytes: 150},
+			poolSize: defaultValuePoolSize, min: 1, max: 150,
+		},
+		{
+			opts:     dbtesterpb.ConfigClientMachineBenchmarkOptions{ValueSizeDistribution: valueSizeHistogram, ValueSizeHistogramPath: f.Name(), ValuePoolSize: 100},
+			poolSize: 100, min: 10, max: 1000,
+		},
+	}
+	for i, tt := range tests {
+		gcfg := dbtesterpb.ConfigClientMachineAgentControl{ConfigClientMachineBenchmarkOptions: &tt.opts}
+		vals, err := newValues(gcfg, mrand.New(mrand.NewSource(1)))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if vals.sampleSize != tt.poolSize || len(vals.bytes) != tt.poolSize {
+			t.Fatalf("#%d: expected %d values, got %d (%d)", i, tt.poolSize, vals.sampleSize, len(vals.bytes))
+		}
+		distinct := make(map[string]struct{})
+		for _, v := range vals.bytes {
+			if len(v) < tt.min || len(v) > tt.max {
+				t.Fatalf("#%d: value size %d is out of [%d, %d]", i, len(v), tt.min, tt.max)
+			}
+			if tt.opts.ValueSizeDistribution == valueSizeHistogram && len(v) == 100 {
+				t.Fatalf("#%d: got value size 100 of zero weight", i)
+			}
+			distinct[string(v)] = struct{}{}
+		}
+		if len(distinct) < tt.poolSize/2 {
+			t.Fatalf("#%d: expected distinct values, got %d out of %d", i, len(distinct), tt.poolSize)
+		}
+	}
+}
+
+func Test_randValue(t *testing.T) {
+	compressed := func(v []byte) int {
+		var buf bytes.Buffer
+		w, _ := flate.NewWriter(&buf, flate.BestCompression)
+		w.Write(v)
+		w.Close()
+		return buf.Len()
+	}
+	rnd := mrand.New(mrand.NewSource(1))
+	random := compressed(randValue(rnd, 10000, 0))
+	half := compressed(randValue(rnd, 10000, 0.5))
+	if half > random*6/10 {
+		t.Fatalf("expected half compressible value to compress to about half of random value (%d), got %d", random, half)
+	}
+	if v := randValue(rnd, 100, 0.9); len(v) != 100 || !bytes.HasSuffix(v, bytes.Repeat([]byte("a"), 90)) {
+		t.Fatalf("unexpected value %q", v)
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	mrand "math/rand"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// ErrVerifyWrites is returned by Stress when the written keys are lost
+// or cannot be read back, after saving all results.
+var ErrVerifyWrites = errors.New("write verification failed")
+
+// VerifyWritesPath returns the path of the write verification results
+// of each endpoint, next to the latency summary file.
+func VerifyWritesPath(summaryPath string) string {
+	ext := filepath.Ext(summaryPath)
+	return strings.TrimSuffix(summaryPath, ext) + "-verify-writes.csv"
+}
+
+// writtenValue is a value that the key may hold after the run.
+type writtenValue struct {
+	hash uint64
+	// ret is the return time in unix nanoseconds,
+	// math.MaxInt64 for the failed put that may take effect any time.
+	ret int64
+}
+
+// writtenKey is the puts to the key that may be the last one applied.
+type writtenKey struct {
+	// acked is true once a put to the key succeeds,
+	// so that the key must exist after the run.
+	acked bool
+	// lastCall is the latest call of the succeeded puts.
+	lastCall int64
+	values   []writtenValue
+}
+
+// writeRecorder records the values written to each key. The put that
+// returned before the call of a succeeded put is overwritten by it, so
+// only the concurrent and the later puts are kept for each key.
+type writeRecorder struct {
+	mu   sync.Mutex
+	keys map[string]*writtenKey
+}
+
+func newWriteRecorder() *writeRecorder {
+	return &writeRecorder{keys: make(map[string]*writtenKey)}
+}
+
+func hashValue(v []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(v)
+	return h.Sum64()
+}
+
+// record records the put. It is a no-op on nil recorder.
+func (w *writeRecorder) record(req Request, start, end time.Time, err error) {
+	if w == nil || req.Op != OpPut {
+		return
+	}
+	call, ret := start.UnixNano(), end.UnixNano()
+	if err != nil {
+		ret = math.MaxInt64
+	}
+	hash := hashValue(req.Value)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	wk, ok := w.keys[req.Key]
+	if !ok {
+		wk = &writtenKey{}
+		w.keys[req.Key] = wk
+	}
+	if err == nil {
+		wk.acked = true
+		if call > wk.lastCall {
+			wk.lastCall = call
+			vs := wk.values[:0]
+			for _, v := range wk.values {
+				if v.ret >= call {
+					vs = append(vs, v)
+				}
+			}
+			wk.values = vs
+		}
+	}
+	if ret >= wk.lastCall {
+		wk.values = append(wk.values, writtenValue{hash: hash, ret: ret})
+	}
+}
+
+// verify returns true if the value read back is one of the written values.
+func (wk *writtenKey) verify(v []byte) bool {
+	hash := hashValue(v)
+	for _, wv := range wk.values {
+		if wv.hash == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// ackedKeys returns the sorted keys with succeeded puts, or the random
+// sample of them if 'sample' > 0.
+func (w *writeRecorder) ackedKeys(sample int64, rnd *mrand.Rand) []string {
+	w.mu.Lock()
+	keys := make([]string, 0, len(w.keys))
+	for k, wk := range w.keys {
+		if wk.acked {
+			keys = append(keys, k)
+		}
+	}
+	w.mu.Unlock()
+	sort.Strings(keys)
+
+	if sample > 0 && sample < int64(len(keys)) {
+		perm := rnd.Perm(len(keys))[:sample]
+		sort.Ints(perm)
+		sampled := make([]string, sample)
+		for i, j := range perm {
+			sampled[i] = keys[j]
+		}
+		keys = sampled
+	}
+	return keys
+}
+
+// verifyResult is the write verification result of an endpoint.
+type verifyResult struct {
+	endpoint   string
+	keys       int64
+	missing    int64
+	mismatched int64
+	// errors is the number of keys failed to read.
+	errors int64
+}
+
+// verifyWrites reads back the written keys from each endpoint, saves
+// the results, and returns ErrVerifyWrites on any missing, mismatched
+// or unreadable key. The reads are not stale, so that the lagging
+// replicas are not reported as lost writes. etcd and ZooKeeper serve
+// them from the connected server once caught up, while Consul forwards
+// them to the leader, so the Consul rows all reflect the leader state.
+// YTsaurus is read once through the RPC proxy.
+func (cfg *Config) verifyWrites(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, w *writeRecorder, rnd *mrand.Rand) error {
+	keys := w.ackedKeys(gcfg.ConfigClientMachineBenchmarkOptions.VerifySampleSize, rnd)
+	eps := verifyEndpoints(gcfg)
+	cfg.lg.Info("verifying written keys", zap.Int("keys", len(keys)), zap.Strings("endpoints", eps))
+
+	rs := make([]verifyResult, 0, len(eps))
+	for _, ep := range eps {
+		r, err := cfg.verifyEndpoint(drv, gcfg, ep, w, keys)
+		if err != nil {
+			return err
+		}
+		cfg.lg.Sugar().Infof("verified %d keys [database: %q | endpoint: %q | missing: %d | mismatched: %d | errors: %d]",
+			r.keys, gcfg.DatabaseID, ep, r.missing, r.mismatched, r.errors)
+		rs = append(rs, r)
+	}
+	cfg.saveVerifyWrites(gcfg, rs)
+
+	var lost, unread int64
+	for _, r := range rs {
+		lost += r.missing + r.mismatched
+		unread += r.errors
+	}
+	switch {
+	case lost > 0:
+		return fmt.Errorf("%w: %q lost %d keys over %d endpoints (see %q)", ErrVerifyWrites, gcfg.DatabaseID, lost, len(rs), VerifyWritesPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath))
+	case unread > 0:
+		return fmt.Errorf("%w: %q failed to read %d keys over %d endpoints", ErrVerifyWrites, gcfg.DatabaseID, unread, len(rs))
+	}
+	return nil
+}
+
+// verifyEndpoints returns the endpoints to read back the keys from.
+// YTsaurus clients always connect to the RPC proxy, so the proxy is
+// the only endpoint instead of each database endpoint.
+func verifyEndpoints(gcfg dbtesterpb.ConfigClientMachineAgentControl) []string {
+	if gcfg.DatabaseID == dbtesterpb.DatabaseID_ytsaurus_cypress.String() && gcfg.Flag_Ytsaurus_Cypress != nil {
+		return []string{gcfg.Flag_Ytsaurus_Cypress.RPCProxy}
+	}
+	return gcfg.DatabaseEndpoints
+}
+
+// verifyEndpoint reads the keys from the endpoint with the clients.
+func (cfg *Config) verifyEndpoint(drv Driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, ep string, w *writeRecorder, keys []string) (verifyResult, error) {
+	r := verifyResult{endpoint: ep, keys: int64(len(keys))}
+
+	copied := gcfg
+	copied.DatabaseEndpoints = []string{ep}
+	clients, closeConns, err := drv.Connect(copied, ClientConfig{
+		TotalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		TotalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+	})
+	if err != nil {
+		return r, err
+	}
+	defer closeConns()
+
+	keyc := make(chan string, len(clients))
+	var wg sync.WaitGroup
+	wg.Add(len(clients))
+	for i := range clients {
+		go func(c Client) {
+			defer wg.Done()
+			for k := range keyc {
+				v, err := readKey(drv, c, k, requestTimeout(gcfg))
+				w.mu.Lock()
+				wk := w.keys[k]
+				w.mu.Unlock()
+				switch {
+				case err != nil && errorCategory(err) != ErrorNotFound:
+					atomic.AddInt64(&r.errors, 1)
+				case wk.verify(v):
+				case v == nil:
+					if atomic.AddInt64(&r.missing, 1) == 1 {
+						cfg.lg.Warn("missing written key", zap.String("endpoint", ep), zap.String("key", k))
+					}
+				default:
+					if atomic.AddInt64(&r.mismatched, 1) == 1 {
+						cfg.lg.Warn("mismatched written key", zap.String("endpoint", ep), zap.String("key", k), zap.Int("value-size", len(v)))
+					}
+				}
+			}
+		}(clients[i])
+	}
+	for _, k := range keys {
+		keyc <- k
+	}
+	close(keyc)
+	wg.Wait()
+	return r, nil
+}
+
+// readKey reads the key within the timeout, 0 for no timeout.
+// The error is categorized by the driver, as the request errors.
+func readKey(drv Driver, c Client, key string, timeout time.Duration) ([]byte, error) {
+	ctx, cancel := context.Background(), func() {}
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+	v, err := c.Get(ctx, key, false)
+	return v, categorizeError(drv, err)
+}
+
+func (cfg *Config) saveVerifyWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, rs []verifyResult) {
+	c1 := dataframe.NewColumn("DATABASE-ID")
+	c2 := dataframe.NewColumn("ENDPOINT")
+	c3 := dataframe.NewColumn("KEYS")
+	c4 := dataframe.NewColumn("MISSING")
+	c5 := dataframe.NewColumn("MISMATCHED")
+	c6 := dataframe.NewColumn("ERRORS")
+	for _, r := range rs {
+		c1.PushBack(dataframe.NewStringValue(gcfg.DatabaseID))
+		c2.PushBack(dataframe.NewStringValue(r.endpoint))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.keys)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.missing)))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.mismatched)))
+		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", r.errors)))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5, c6} {
+		if err := fr.AddColumn(col); err != nil {
+			panic(err)
+		}
+	}
+	if err := fr.CSV(VerifyWritesPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)); err != nil {
+		panic(err)
+	}
+}
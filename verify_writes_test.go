@@ -0,0 +1,179 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"io/ioutil"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+func Test_writeRecorder(t *testing.T) {
+	at := func(ms int64) time.Time { return time.Unix(0, ms*int64(time.Millisecond)) }
+	put := func(key, value string) Request { return Request{Op: OpPut, Key: key, Value: []byte(value)} }
+
+	w := newWriteRecorder()
+	w.record(put("a", "1"), at(0), at(10), nil)
+	// overwritten by the put called after it returned
+	w.record(put("a", "2"), at(20), at(30), nil)
+	// concurrent with the last put
+	w.record(put("a", "3"), at(25), at(35), nil)
+	// the failed put may take effect after the last put
+	w.record(put("a", "4"), at(5), at(6), errRequestTimeout)
+	w.record(put("b", "1"), at(0), at(10), errRequestTimeout)
+	w.record(Request{Op: OpGet, Key: "c"}, at(0), at(10), nil)
+
+	for v, ok := range map[string]bool{"1": false, "2": true, "3": true, "4": true} {
+		if w.keys["a"].verify([]byte(v)) != ok {
+			t.Fatalf("expected verified %q %v", v, ok)
+		}
+	}
+	if keys := w.ackedKeys(0, nil); !reflect.DeepEqual(keys, []string{"a"}) {
+		t.Fatalf("expected only the acknowledged key, got %v", keys)
+	}
+
+	var nilRecorder *writeRecorder
+	nilRecorder.record(put("a", "1"), at(0), at(10), nil)
+}
+
+func Test_writeRecorder_ackedKeys(t *testing.T) {
+	w := newWriteRecorder()
+	for _, k := range []string{"e", "d", "c", "b", "a"} {
+		w.record(Request{Op: OpPut, Key: k}, time.Now(), time.Now(), nil)
+	}
+	if keys := w.ackedKeys(0, nil); !reflect.DeepEqual(keys, []string{"a", "b", "c", "d", "e"}) {
+		t.Fatalf("expected sorted keys, got %v", keys)
+	}
+	keys := w.ackedKeys(3, mrand.New(mrand.NewSource(1)))
+	if len(keys) != 3 || !reflect.DeepEqual(keys, w.ackedKeys(3, mrand.New(mrand.NewSource(1)))) {
+		t.Fatalf("expected the same sample of 3 keys with the same seed, got %v", keys)
+	}
+}
+
+// memVerifyDriver connects to the in-memory key-values of each endpoint.
+type memVerifyDriver struct {
+	Driver
+	endpoints map[string]map[string]string
+}
+
+// errMemNoKey is the not-found error only recognized by memVerifyDriver.
+var errMemNoKey = errors.New("has no child with key")
+
+func (d memVerifyDriver) ClassifyError(err error) string {
+	if err == errMemNoKey {
+		return ErrorNotFound
+	}
+	return ""
+}
+
+func (d memVerifyDriver) Connect(gcfg dbtesterpb.ConfigClientMachineAgentControl, ccfg ClientConfig) ([]Client, func(), error) {
+	cs := make([]Client, ccfg.TotalClients)
+	for i := range cs {
+		cs[i] = memVerifyClient{kvs: d.endpoints[gcfg.DatabaseEndpoints[0]]}
+	}
+	return cs, func() {}, nil
+}
+
+type memVerifyClient struct {
+	Client
+	kvs map[string]string
+}
+
+func (c memVerifyClient) Get(ctx context.Context, key string, staleRead bool) ([]byte, error) {
+	if key == "unavailable" {
+		return nil, errors.New("unavailable")
+	}
+	v, ok := c.kvs[key]
+	if !ok {
+		return nil, errMemNoKey
+	}
+	return []byte(v), nil
+}
+
+func Test_verifyWrites(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "verify-writes-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := &Config{lg: zap.NewNop()}
+	cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = filepath.Join(dir, "summary.csv")
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID:                          "etcd__tip",
+		DatabaseEndpoints:                   []string{"ep1", "ep2"},
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{ClientNumber: 2},
+	}
+
+	w := newWriteRecorder()
+	for _, k := range []string{"a", "b", "c"} {
+		w.record(Request{Op: OpPut, Key: k, Value: []byte("v")}, time.Now(), time.Now(), nil)
+	}
+	drv := memVerifyDriver{endpoints: map[string]map[string]string{
+		"ep1": {"a": "v", "b": "v", "c": "v"},
+		"ep2": {"a": "v", "b": "v", "c": "v"},
+	}}
+	if err = cfg.verifyWrites(drv, gcfg, w, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	drv.endpoints["ep2"] = map[string]string{"a": "v", "b": "x"}
+	err = cfg.verifyWrites(drv, gcfg, w, nil)
+	if !errors.Is(err, ErrVerifyWrites) {
+		t.Fatalf("expected %v, got %v", ErrVerifyWrites, err)
+	}
+	b, err := ioutil.ReadFile(VerifyWritesPath(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "DATABASE-ID,ENDPOINT,KEYS,MISSING,MISMATCHED,ERRORS\netcd__tip,ep1,3,0,0,0\netcd__tip,ep2,3,1,1,0\n"
+	if string(b) != expected {
+		t.Fatalf("expected %q, got %q", expected, string(b))
+	}
+
+	// the keys failed to read cannot be verified
+	w.record(Request{Op: OpPut, Key: "unavailable"}, time.Now(), time.Now(), nil)
+	drv.endpoints["ep2"] = drv.endpoints["ep1"]
+	if err = cfg.verifyWrites(drv, gcfg, w, nil); !errors.Is(err, ErrVerifyWrites) || !strings.Contains(err.Error(), "failed to read 2 keys") {
+		t.Fatalf("expected read failures, got %v", err)
+	}
+}
+
+func Test_verifyEndpoints(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID:        "etcd__tip",
+		DatabaseEndpoints: []string{"ep1", "ep2"},
+	}
+	if eps := verifyEndpoints(gcfg); !reflect.DeepEqual(eps, gcfg.DatabaseEndpoints) {
+		t.Fatalf("expected database endpoints, got %v", eps)
+	}
+
+	gcfg.DatabaseID = dbtesterpb.DatabaseID_ytsaurus_cypress.String()
+	gcfg.Flag_Ytsaurus_Cypress = &dbtesterpb.Flag_Ytsaurus_Cypress{RPCProxy: "proxy:9013"}
+	if eps := verifyEndpoints(gcfg); !reflect.DeepEqual(eps, []string{"proxy:9013"}) {
+		t.Fatalf("expected only the RPC proxy, got %v", eps)
+	}
+}